@@ -0,0 +1,60 @@
+package vector
+
+// AABB is an axis-aligned bounding box described by its minimum and maximum
+// corner
+type AABB struct {
+	Min, Max Vector
+}
+
+// NewAABBFromPoints returns the smallest box that contains a set of points,
+// see Bounds
+func NewAABBFromPoints(vs []Vector) (AABB, error) {
+	return Bounds(vs)
+}
+
+// Contains reports if a point is inside the box or on its surface, missing
+// components of the point are treated as 0
+func (b AABB) Contains(v Vector) bool {
+	for i := range b.Min {
+		if i >= len(b.Max) {
+			break
+		}
+
+		if x := at(v, i); x < b.Min[i] || x > b.Max[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Intersects reports if two boxes overlap or touch, only the axis both boxes
+// have are compared
+func (b AABB) Intersects(other AABB) bool {
+	for i := range b.Min {
+		if i >= len(b.Max) || i >= len(other.Min) || i >= len(other.Max) {
+			break
+		}
+
+		if b.Max[i] < other.Min[i] || other.Max[i] < b.Min[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Expand returns a copy of the box grown to contain a point
+func (b AABB) Expand(v Vector) AABB {
+	return AABB{Min(b.Min, v), Max(b.Max, v)}
+}
+
+// Center returns the point in the middle of the box
+func (b AABB) Center() Vector {
+	return Add(b.Min, b.Max).Scale(0.5)
+}
+
+// Size returns the length of the box along each axis
+func (b AABB) Size() Vector {
+	return Sub(b.Max, b.Min)
+}
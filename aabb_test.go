@@ -0,0 +1,46 @@
+package vector_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/kvartborg/vector"
+)
+
+func TestAABBContains(t *testing.T) {
+	box := vector.AABB{Min: vec{0, 0, 0}, Max: vec{2, 2, 2}}
+
+	if !box.Contains(vec{1, 1, 1}) || !box.Contains(vec{2, 0, 1}) || !box.Contains(vec{1, 1}) {
+		t.Error("expected the points to be inside the box")
+	}
+
+	if box.Contains(vec{1, 3, 1}) || box.Contains(vec{-1, 1, 1}) {
+		t.Error("expected the points to be outside the box")
+	}
+}
+
+func TestAABBIntersects(t *testing.T) {
+	box := vector.AABB{Min: vec{0, 0}, Max: vec{2, 2}}
+
+	if !box.Intersects(vector.AABB{Min: vec{1, 1}, Max: vec{3, 3}}) || !box.Intersects(vector.AABB{Min: vec{2, 0}, Max: vec{3, 1}}) {
+		t.Error("expected the boxes to intersect")
+	}
+
+	if box.Intersects(vector.AABB{Min: vec{3, 0}, Max: vec{4, 2}}) {
+		t.Error("expected the boxes to not intersect")
+	}
+}
+
+func ExampleNewAABBFromPoints() {
+	box, _ := vector.NewAABBFromPoints([]vec{{1, 4}, {3, 0}, {-1, 2}})
+
+	fmt.Println(box.Min, box.Max, box.Center(), box.Size())
+	// Output: [-1 0] [3 4] [1 2] [4 4]
+}
+
+func ExampleAABB_Expand() {
+	box := vector.AABB{Min: vec{0, 0}, Max: vec{1, 1}}.Expand(vec{3, -1})
+
+	fmt.Println(box.Min, box.Max)
+	// Output: [0 -1] [3 1]
+}
@@ -0,0 +1,182 @@
+package vector
+
+import "math"
+
+// TurnToward2D rotates a 2-dimensional facing direction toward a target by
+// at most maxTurn radians and returns the result as a new vector. The target
+// is relative to the one facing, for a target position use
+// vector.Sub(target, position). The length of the facing vector is kept.
+func TurnToward2D(facing, target Vector, maxTurn float64) Vector {
+	if target.X() == 0 && target.Y() == 0 {
+		return Vector{facing.X(), facing.Y()}
+	}
+
+	delta := AngleDiff(facing.Heading(), target.Heading())
+
+	if delta > maxTurn {
+		delta = maxTurn
+	} else if delta < -maxTurn {
+		delta = -maxTurn
+	}
+
+	return Vector{facing.X(), facing.Y()}.Rotate(delta)
+}
+
+// SignedAngleAround returns the angle in radians between two 3-dimensional
+// vectors when seen along an axis, for example the yaw between two
+// directions when the axis points up. The vectors are projected onto the
+// plane perpendicular to the axis and the angle is positive when the
+// rotation from v1 to v2 is counterclockwise around the axis, the result is
+// in the range [-π, π].
+func SignedAngleAround(v1, v2, axis Vector) (float64, error) {
+	if len(v1) != 3 || len(v2) != 3 || len(axis) != 3 {
+		return 0, ErrNot3Dimensional
+	}
+
+	n := Unit(axis)
+
+	if n.Magnitude() < 1e-8 {
+		return 0, ErrZeroVector
+	}
+
+	a := Sub(v1, Scale(n, Dot(v1, n)))
+	b := Sub(v2, Scale(n, Dot(v2, n)))
+	c, _ := Cross(a, b)
+
+	return math.Atan2(Dot(c, n), Dot(a, b)), nil
+}
+
+// RotateToward returns a direction rotated toward a target direction by at
+// most maxRadians
+func RotateToward(v, target Vector, maxRadians float64) Vector {
+	return v.Clone().RotateToward(target, maxRadians)
+}
+
+// RotateToward rotates a direction toward a target direction by at most
+// maxRadians, the rotation happens in the plane spanned by both directions
+// and the length of the vector is kept. The target is cut to the dimension
+// of the vector and missing components are treated as 0.
+func (v Vector) RotateToward(target Vector, maxRadians float64) Vector {
+	l := v.Magnitude()
+	t := make(Vector, len(v))
+
+	for i := range t {
+		t[i] = at(target, i)
+	}
+
+	if l < 1e-8 || t.Unit().Magnitude() < 1e-8 {
+		return v
+	}
+
+	u := Scale(v, 1/l)
+	cos := Dot(u, t)
+
+	if math.Acos(math.Max(-1, math.Min(1, cos))) <= maxRadians {
+		copy(v, t.Scale(l))
+		return v
+	}
+
+	perp := Sub(t, Scale(u, cos))
+
+	// the directions are opposite, any perpendicular direction is as close
+	if perp.Magnitude() < 1e-8 {
+		perp = perpendicularTo(u)
+	}
+
+	sin, cos := math.Sincos(maxRadians)
+	copy(v, u.Scale(cos).Add(perp.Unit().Scale(sin)).Scale(l))
+
+	return v
+}
+
+// perpendicularTo returns a vector perpendicular to the unit vector u, by
+// removing u from the axis it is least aligned with
+func perpendicularTo(u Vector) Vector {
+	axis := 0
+
+	for i := range u {
+		if math.Abs(u[i]) < math.Abs(u[axis]) {
+			axis = i
+		}
+	}
+
+	e := make(Vector, len(u))
+	e[axis] = 1
+
+	return e.Sub(Scale(u, u[axis]))
+}
+
+// Heading returns the angle in radians of a 2-dimensional vector from the x
+// axis, in the range [-π, π]
+func Heading(v Vector) float64 {
+	return v.Heading()
+}
+
+// Heading returns the angle in radians of a 2-dimensional vector from the x
+// axis, in the range [-π, π]
+func (v Vector) Heading() float64 {
+	return math.Atan2(v.Y(), v.X())
+}
+
+// SignedAngle returns the smallest rotation in radians from one
+// 2-dimensional vector to another
+func SignedAngle(v1, v2 Vector) float64 {
+	return v1.SignedAngle(v2)
+}
+
+// SignedAngle returns the smallest rotation in radians from the vector to
+// another 2-dimensional vector, it is positive when the rotation is
+// counterclockwise and in the range [-π, π]
+func (v Vector) SignedAngle(v2 Vector) float64 {
+	return math.Atan2(v.Cross2D(v2), v.X()*v2.X()+v.Y()*v2.Y())
+}
+
+// Perpendicular returns a 2-dimensional vector rotated 90 degrees
+// counterclockwise
+func Perpendicular(v Vector) Vector {
+	return v.Clone().Perpendicular()
+}
+
+// Perpendicular rotates a 2-dimensional vector 90 degrees counterclockwise,
+// the left normal of an edge is the perpendicular of its direction. Like
+// Rotate missing dimensions are added, and extra dimensions are cut.
+func (v Vector) Perpendicular() Vector {
+	for len(v) < 2 {
+		v = append(v, 0)
+	}
+
+	v[X], v[Y] = -v[Y], v[X]
+
+	return v[:2]
+}
+
+// Cross2D returns the z component of the cross product of two 2-dimensional
+// vectors
+func Cross2D(v1, v2 Vector) float64 {
+	return v1.Cross2D(v2)
+}
+
+// Cross2D returns the z component of the cross product of two 2-dimensional
+// vectors, it is positive when v2 is to the left of the vector, negative
+// when it is to the right and 0 when they are parallel
+func (v Vector) Cross2D(v2 Vector) float64 {
+	return v.X()*v2.Y() - v.Y()*v2.X()
+}
+
+// WrapAngle wraps an angle in radians into the range [-π, π)
+func WrapAngle(radians float64) float64 {
+	return radians - 2*math.Pi*math.Floor((radians+math.Pi)/(2*math.Pi))
+}
+
+// AngleDiff returns the smallest signed rotation in radians from angle a to
+// angle b, in the range [-π, π)
+func AngleDiff(a, b float64) float64 {
+	return WrapAngle(b - a)
+}
+
+// LerpAngle interpolates between two angles in radians along the shortest
+// way around the circle, t of 0 returns a and 1 returns b. The result is not
+// wrapped, use WrapAngle if needed.
+func LerpAngle(a, b, t float64) float64 {
+	return a + AngleDiff(a, b)*t
+}
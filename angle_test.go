@@ -0,0 +1,180 @@
+package vector_test
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/kvartborg/vector"
+)
+
+func TestTurnToward2D(t *testing.T) {
+	facing := vec{2, 0}
+
+	result := vector.TurnToward2D(facing, vec{0, -5}, math.Pi/4)
+
+	if !result.Equal(vec{math.Sqrt2, -math.Sqrt2}) {
+		t.Errorf("did not turn clockwise by the max turn, got %v", result)
+	}
+
+	result = vector.TurnToward2D(facing, vec{-1, 0.1}, math.Pi/4)
+
+	if !result.Equal(vec{math.Sqrt2, math.Sqrt2}) {
+		t.Errorf("did not turn the shortest way, got %v", result)
+	}
+
+	result = vector.TurnToward2D(facing, vec{1, 1}, math.Pi)
+
+	if !result.Equal(vec{math.Sqrt2, math.Sqrt2}) {
+		t.Errorf("did not stop at the target, got %v", result)
+	}
+
+	if !facing.Equal(vec{2, 0}) {
+		t.Error("facing vector was modified")
+	}
+}
+
+func ExampleTurnToward2D() {
+	fmt.Println(
+		vector.TurnToward2D(vec{1, 0}, vec{0, 3}, math.Pi),
+	)
+	// Output: [0 1]
+}
+
+func TestSignedAngleAround(t *testing.T) {
+	up := vec{0, 0, 1}
+
+	angle, err := vector.SignedAngleAround(vec{1, 0, 5}, vec{0, 1, -2}, up)
+
+	if err != nil || math.Abs(angle-math.Pi/2) > 1e-8 {
+		t.Errorf("expected π/2, got %v %v", angle, err)
+	}
+
+	angle, _ = vector.SignedAngleAround(vec{1, 0, 0}, vec{0, 1, 0}, vec{0, 0, -1})
+
+	if math.Abs(angle+math.Pi/2) > 1e-8 {
+		t.Errorf("expected -π/2 with a flipped axis, got %v", angle)
+	}
+
+	if _, err := vector.SignedAngleAround(vec{1, 0}, vec{0, 1}, up); err != vector.ErrNot3Dimensional {
+		t.Error("expected 2-dimensional vectors to be rejected")
+	}
+
+	if _, err := vector.SignedAngleAround(vec{1, 0, 0}, vec{0, 1, 0}, vec{0, 0, 0}); err != vector.ErrZeroVector {
+		t.Error("expected zero axis to be rejected")
+	}
+}
+
+func ExampleSignedAngleAround() {
+	fmt.Println(
+		vector.SignedAngleAround(vec{0, 1, 0}, vec{1, 0, 0}, vec{0, 0, 1}),
+	)
+	// Output: -1.5707963267948966 <nil>
+}
+
+func TestRotateTowardOpposite(t *testing.T) {
+	v := vector.RotateToward(vec{2, 0, 0}, vec{-1, 0, 0}, math.Pi/2)
+
+	if math.Abs(v.X()) > 1e-9 || math.Abs(v.Magnitude()-2) > 1e-9 {
+		t.Errorf("expected a perpendicular vector of length 2, got %v", v)
+	}
+}
+
+func ExampleRotateToward() {
+	fmt.Println(
+		vector.RotateToward(vec{2, 0}, vec{0, 5}, math.Pi/2),
+		vector.RotateToward(vec{0, 0, 3}, vec{1, 0, 0}, 10),
+	)
+	// Output: [0 2] [3 0 0]
+}
+
+func ExampleVector_RotateToward() {
+	fmt.Println(
+		vec{1, 0}.RotateToward(vec{-1, 1}, math.Pi/2),
+	)
+	// Output: [0 1]
+}
+
+func ExampleHeading() {
+	fmt.Println(
+		vector.Heading(vec{0, 2}) == math.Pi/2,
+	)
+	// Output: true
+}
+
+func ExampleVector_Heading() {
+	fmt.Println(
+		vec{1, 0}.Heading(),
+	)
+	// Output: 0
+}
+
+func ExampleSignedAngle() {
+	fmt.Println(
+		vector.SignedAngle(vec{1, 0}, vec{0, 1}) == math.Pi/2,
+		vector.SignedAngle(vec{1, 0}, vec{0, -1}) == -math.Pi/2,
+	)
+	// Output: true true
+}
+
+func ExampleVector_SignedAngle() {
+	fmt.Println(
+		vec{0, 3}.SignedAngle(vec{0, 1}),
+	)
+	// Output: 0
+}
+
+func ExamplePerpendicular() {
+	fmt.Println(
+		vector.Perpendicular(vec{1, 2}),
+	)
+	// Output: [-2 1]
+}
+
+func ExampleVector_Perpendicular() {
+	fmt.Println(
+		vec{3, 4, 5}.Perpendicular(),
+	)
+	// Output: [-4 3]
+}
+
+func ExampleCross2D() {
+	fmt.Println(
+		vector.Cross2D(vec{1, 0}, vec{0, 1}),
+		vector.Cross2D(vec{1, 0}, vec{0, -1}),
+	)
+	// Output: 1 -1
+}
+
+func ExampleVector_Cross2D() {
+	fmt.Println(
+		vec{2, 2}.Cross2D(vec{1, 1}),
+	)
+	// Output: 0
+}
+
+func TestWrapAngle(t *testing.T) {
+	cases := map[float64]float64{
+		0:               0,
+		math.Pi:         -math.Pi,
+		3 * math.Pi / 2: -math.Pi / 2,
+		-5 * math.Pi:    -math.Pi,
+		7:               7 - 2*math.Pi,
+	}
+
+	for in, expected := range cases {
+		if out := vector.WrapAngle(in); math.Abs(out-expected) > 1e-12 {
+			t.Errorf("expected %v to wrap to %v, got %v", in, expected, out)
+		}
+	}
+}
+
+func ExampleAngleDiff() {
+	fmt.Printf("%.2f\n", vector.AngleDiff(0.1, 2*math.Pi-0.1))
+	// Output: -0.20
+}
+
+func ExampleLerpAngle() {
+	fmt.Printf("%.2f\n", vector.LerpAngle(3, -3, 0.5))
+	// Output: 3.14
+}
@@ -0,0 +1,274 @@
+package vector
+
+import (
+	"encoding/binary"
+	"errors"
+	"math"
+	"strconv"
+)
+
+var (
+	// ErrInvalidArrow is returned when a buffer is not an Arrow IPC stream
+	// with float64 columns
+	ErrInvalidArrow = errors.New("invalid arrow stream")
+)
+
+// values from the Message.fbs and Schema.fbs schemas of the Arrow format
+const (
+	arrowContinuation      = 0xFFFFFFFF
+	arrowMetadataV5        = 4
+	arrowHeaderSchema      = 1
+	arrowHeaderRecordBatch = 3
+	arrowTypeFloatingPoint = 3
+	arrowPrecisionDouble   = 2
+)
+
+// EncodeArrow encodes a set of vectors as an Apache Arrow IPC stream with a
+// single record batch, holding one non-nullable float64 column per axis. The
+// columns are named x, y, z and w followed by the index of the axis for
+// higher dimensions, vectors with a lower dimension are padded with 0. The
+// stream can be read by the Arrow libraries, like pyarrow.ipc.open_stream,
+// to analyse the vectors with data science tools.
+func EncodeArrow(vs []Vector) []byte {
+	columns := Columns(vs)
+	rows := int64(len(vs))
+
+	b := &flatBuilder{}
+	fields := make([]int, len(columns))
+
+	for i := range columns {
+		name := b.string(arrowColumnName(i))
+		precision := b.table(flatField{size: 2, value: arrowPrecisionDouble})
+		children := b.offsets()
+
+		fields[i] = b.table(
+			flatRef(name),
+			flatField{}, // nullable, false when not set
+			flatField{size: 1, value: arrowTypeFloatingPoint},
+			flatRef(precision),
+			flatField{}, // dictionary
+			flatRef(children),
+		)
+	}
+
+	list := b.offsets(fields...)
+	schema := b.table(flatField{}, flatRef(list))
+	buf := appendArrowMessage(nil, arrowMessage(b, arrowHeaderSchema, schema, 0), nil)
+
+	body := make([]byte, 0, 8*len(vs)*len(columns))
+	nodes := make([][2]int64, len(columns))
+	buffers := make([][2]int64, 0, 2*len(columns))
+
+	for i, column := range columns {
+		nodes[i] = [2]int64{rows, 0}
+
+		// the validity bitmap can be left empty when there are no nulls
+		buffers = append(buffers, [2]int64{int64(len(body)), 0}, [2]int64{int64(len(body)), 8 * rows})
+
+		for _, scalar := range column {
+			body = appendUint64(body, math.Float64bits(scalar))
+		}
+	}
+
+	b = &flatBuilder{}
+	nodeList := b.pairs(nodes)
+	bufferList := b.pairs(buffers)
+	batch := b.table(flatField{size: 8, value: uint64(rows)}, flatRef(nodeList), flatRef(bufferList))
+	buf = appendArrowMessage(buf, arrowMessage(b, arrowHeaderRecordBatch, batch, int64(len(body))), body)
+
+	// end of stream marker
+	return appendUint32(appendUint32(buf, arrowContinuation), 0)
+}
+
+// DecodeArrow reads the vectors of an Apache Arrow IPC stream, where each
+// column of the schema is a float64 column holding an axis of the vectors.
+// The rows of all record batches are returned in order and null values are
+// read as 0.
+func DecodeArrow(buf []byte) ([]Vector, error) {
+	var columns [][]float64
+	schema := false
+
+	for len(buf) >= 4 {
+		size := binary.LittleEndian.Uint32(buf)
+		buf = buf[4:]
+
+		// streams written before Arrow 0.15 do not have the continuation marker
+		if size == arrowContinuation {
+			if len(buf) < 4 {
+				return nil, ErrInvalidArrow
+			}
+
+			size = binary.LittleEndian.Uint32(buf)
+			buf = buf[4:]
+		}
+
+		if size == 0 {
+			break
+		}
+
+		if size < 4 || uint64(size) > uint64(len(buf)) {
+			return nil, ErrInvalidArrow
+		}
+
+		metadata := buf[:size]
+		buf = buf[size:]
+
+		message := flatTableAt(metadata, int(binary.LittleEndian.Uint32(metadata)))
+		headerType, okType := message.scalar(1, 1, 0)
+		header, okHeader := message.ref(2)
+		bodyLength, okBody := message.scalar(3, 8, 0)
+
+		if !okType || !okHeader || !okBody || header == 0 || bodyLength > uint64(len(buf)) {
+			return nil, ErrInvalidArrow
+		}
+
+		body := buf[:bodyLength]
+		buf = buf[bodyLength:]
+
+		switch {
+		case headerType == arrowHeaderSchema && !schema:
+			n, ok := decodeArrowSchema(flatTableAt(metadata, header))
+
+			if !ok {
+				return nil, ErrInvalidArrow
+			}
+
+			columns = make([][]float64, n)
+			schema = true
+		case headerType == arrowHeaderRecordBatch && schema:
+			if !decodeArrowRecordBatch(flatTableAt(metadata, header), body, columns) {
+				return nil, ErrInvalidArrow
+			}
+		default:
+			return nil, ErrInvalidArrow
+		}
+	}
+
+	if !schema {
+		return nil, ErrInvalidArrow
+	}
+
+	return FromColumns(columns)
+}
+
+// arrowColumnName returns the name of the column for an axis, it matches the
+// names used by `vector` struct tags
+func arrowColumnName(axis int) string {
+	if axis < 4 {
+		return "xyzw"[axis : axis+1]
+	}
+
+	return strconv.Itoa(axis)
+}
+
+// arrowMessage finishes the builder with a Message table wrapping a header
+func arrowMessage(b *flatBuilder, headerType byte, header int, bodyLength int64) []byte {
+	message := b.table(
+		flatField{size: 2, value: arrowMetadataV5},
+		flatField{size: 1, value: uint64(headerType)},
+		flatRef(header),
+		flatField{size: 8, value: uint64(bodyLength)},
+	)
+
+	return b.finish(message)
+}
+
+// appendArrowMessage appends an encapsulated message to a stream, the
+// metadata and body must be padded to a multiple of 8 bytes
+func appendArrowMessage(buf, metadata, body []byte) []byte {
+	buf = appendUint32(buf, arrowContinuation)
+	buf = appendUint32(buf, uint32(len(metadata)))
+	buf = append(buf, metadata...)
+	return append(buf, body...)
+}
+
+// decodeArrowSchema returns the number of columns of a schema, it is not ok
+// if the columns are not float64 columns
+func decodeArrowSchema(schema flatTable) (int, bool) {
+	endianness, ok := schema.scalar(0, 2, 0)
+	fields, n, okFields := schema.vector(1, 4)
+
+	if !ok || !okFields || endianness != 0 {
+		return 0, false
+	}
+
+	for i := 0; i < n; i++ {
+		slot := fields + 4*i
+		field := flatTableAt(schema.buf, slot+int(binary.LittleEndian.Uint32(schema.buf[slot:])))
+		typeType, okType := field.scalar(2, 1, 0)
+		typ, okRef := field.ref(3)
+		dictionary, okDictionary := field.ref(4)
+
+		if !okType || !okRef || !okDictionary || typ == 0 || dictionary != 0 || typeType != arrowTypeFloatingPoint {
+			return 0, false
+		}
+
+		if precision, ok := flatTableAt(schema.buf, typ).scalar(0, 2, 0); !ok || precision != arrowPrecisionDouble {
+			return 0, false
+		}
+	}
+
+	return n, true
+}
+
+// decodeArrowRecordBatch appends the rows of a record batch to the columns
+func decodeArrowRecordBatch(batch flatTable, body []byte, columns [][]float64) bool {
+	length, ok := batch.scalar(0, 8, 0)
+	nodes, nodeCount, okNodes := batch.vector(1, 16)
+	buffers, bufferCount, okBuffers := batch.vector(2, 16)
+	compression, okCompression := batch.ref(3)
+
+	if !ok || !okNodes || !okBuffers || !okCompression || compression != 0 {
+		return false
+	}
+
+	if nodeCount != len(columns) || bufferCount != 2*len(columns) {
+		return false
+	}
+
+	if len(columns) > 0 && length > uint64(len(body)/8) {
+		return false
+	}
+
+	rows := int(length)
+
+	for i := range columns {
+		node := nodes + 16*i
+		nulls := binary.LittleEndian.Uint64(batch.buf[node+8:])
+		validity, okValidity := arrowBuffer(batch.buf, buffers+32*i, body)
+		values, okValues := arrowBuffer(batch.buf, buffers+32*i+16, body)
+
+		if binary.LittleEndian.Uint64(batch.buf[node:]) != length || !okValidity || !okValues || len(values) < 8*rows {
+			return false
+		}
+
+		if nulls > 0 && len(validity) < (rows+7)/8 {
+			return false
+		}
+
+		for j := 0; j < rows; j++ {
+			scalar := 0.
+
+			if nulls == 0 || validity[j/8]&(1<<uint(j%8)) != 0 {
+				scalar = math.Float64frombits(binary.LittleEndian.Uint64(values[8*j:]))
+			}
+
+			columns[i] = append(columns[i], scalar)
+		}
+	}
+
+	return true
+}
+
+// arrowBuffer returns the part of the body described by the Buffer struct at
+// pos in the metadata
+func arrowBuffer(metadata []byte, pos int, body []byte) ([]byte, bool) {
+	offset := binary.LittleEndian.Uint64(metadata[pos:])
+	length := binary.LittleEndian.Uint64(metadata[pos+8:])
+
+	if offset > uint64(len(body)) || length > uint64(len(body))-offset {
+		return nil, false
+	}
+
+	return body[offset : offset+length], true
+}
@@ -0,0 +1,74 @@
+package vector_test
+
+import (
+	"encoding/binary"
+	"fmt"
+	"testing"
+
+	"github.com/kvartborg/vector"
+)
+
+func TestArrowRoundTrip(t *testing.T) {
+	for _, vs := range [][]vec{
+		{{1, 2, 3}, {4, 5}, {6, 7, 8, 9, 10}},
+		{{1.5}},
+		{},
+	} {
+		buf := vector.EncodeArrow(vs)
+		result, err := vector.DecodeArrow(buf)
+
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if len(result) != len(vs) {
+			t.Fatalf("expected %d vectors, got %d", len(vs), len(result))
+		}
+
+		for i := range vs {
+			expected := vs[i].Clone().Resize(len(result[i]))
+
+			if !result[i].Equal(expected) {
+				t.Errorf("expected %v, got %v", expected, result[i])
+			}
+		}
+	}
+}
+
+func TestArrowFraming(t *testing.T) {
+	buf := vector.EncodeArrow([]vec{{1, 2}, {3, 4}})
+
+	// each message starts with a continuation marker and the length of the
+	// metadata, which is padded to 8 bytes, and the stream ends with a
+	// marker followed by a length of 0
+	if binary.LittleEndian.Uint32(buf) != 0xFFFFFFFF || binary.LittleEndian.Uint32(buf[4:])%8 != 0 {
+		t.Errorf("expected an encapsulated schema message, got % x", buf[:8])
+	}
+
+	if end := buf[len(buf)-8:]; binary.LittleEndian.Uint32(end) != 0xFFFFFFFF || binary.LittleEndian.Uint32(end[4:]) != 0 {
+		t.Errorf("expected an end of stream marker, got % x", end)
+	}
+
+	if len(buf)%8 != 0 {
+		t.Errorf("expected the stream to be padded to 8 bytes, got %d bytes", len(buf))
+	}
+}
+
+func TestArrowInvalid(t *testing.T) {
+	buf := vector.EncodeArrow([]vec{{1, 2, 3}})
+
+	for _, b := range [][]byte{nil, buf[:len(buf)-16], buf[:12], buf[8:]} {
+		if _, err := vector.DecodeArrow(b); err != vector.ErrInvalidArrow {
+			t.Errorf("expected %d bytes to be invalid, got %v", len(b), err)
+		}
+	}
+}
+
+func ExampleEncodeArrow() {
+	buf := vector.EncodeArrow([]vec{{1, 2, 3}, {4, 5}})
+
+	fmt.Println(
+		vector.DecodeArrow(buf),
+	)
+	// Output: [[1 2 3] [4 5 0]] <nil>
+}
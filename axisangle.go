@@ -0,0 +1,61 @@
+package vector
+
+import "math"
+
+// AxisAngle describes a rotation of Angle radians counterclockwise around
+// an Axis through the origin
+type AxisAngle struct {
+	Axis  Vector
+	Angle float64
+}
+
+// Quaternion returns the rotation as a quaternion
+func (a AxisAngle) Quaternion() Quaternion {
+	return QuaternionFromAxisAngle(a.Axis, a.Angle)
+}
+
+// Matrix3 returns the rotation as a 3x3 matrix
+func (a AxisAngle) Matrix3() Matrix3 {
+	return Matrix3FromAxisAngle(a.Axis, a.Angle)
+}
+
+// AxisAngle returns the rotation of the quaternion as an axis with a length
+// of one and an angle in the range [0, π]. The identity has no axis and is
+// returned as a rotation of 0 around the x axis.
+func (q Quaternion) AxisAngle() AxisAngle {
+	q = q.Normalize()
+
+	if q.W < 0 {
+		q = Quaternion{-q.W, -q.X, -q.Y, -q.Z}
+	}
+
+	s := math.Sqrt(math.Max(0, 1-q.W*q.W))
+
+	if s < 1e-8 {
+		return AxisAngle{Vector{1, 0, 0}, 0}
+	}
+
+	return AxisAngle{
+		Vector{q.X / s, q.Y / s, q.Z / s},
+		2 * math.Acos(math.Min(1, q.W)),
+	}
+}
+
+// AxisAngle returns the rotation of the matrix as an axis and an angle, the
+// matrix is expected to be a rotation matrix
+func (m Matrix3) AxisAngle() AxisAngle {
+	return QuaternionFromMatrix3(m).AxisAngle()
+}
+
+// RotateByAxisAngle rotates a vector around the axis of an axis-angle
+// rotation
+func RotateByAxisAngle(v Vector, a AxisAngle) Vector {
+	return v.Clone().RotateByAxisAngle(a)
+}
+
+// RotateByAxisAngle rotates a vector around the axis of an axis-angle
+// rotation, like RotateAroundAxisPoint the vector is cut or padded to 3
+// dimensions
+func (v Vector) RotateByAxisAngle(a AxisAngle) Vector {
+	return v.RotateAroundAxisPoint(a.Axis, nil, a.Angle)
+}
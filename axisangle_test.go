@@ -0,0 +1,37 @@
+package vector_test
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/kvartborg/vector"
+)
+
+func TestAxisAngleRoundTrip(t *testing.T) {
+	a := vector.AxisAngle{Axis: vec{0, 3, 4}, Angle: 1.2}
+
+	for _, result := range []vector.AxisAngle{a.Quaternion().AxisAngle(), a.Matrix3().AxisAngle()} {
+		if !result.Axis.Equal(vec{0, 0.6, 0.8}) || math.Abs(result.Angle-1.2) > 1e-8 {
+			t.Errorf("expected the axis [0 0.6 0.8] and angle 1.2, got %v", result)
+		}
+	}
+}
+
+func ExampleRotateByAxisAngle() {
+	a := vector.AxisAngle{Axis: vec{0, 0, 1}, Angle: math.Pi}
+
+	fmt.Println(
+		vector.RotateByAxisAngle(vec{1, 0, 0}, a).Equal(vec{-1, 0, 0}),
+	)
+	// Output: true
+}
+
+func ExampleVector_RotateByAxisAngle() {
+	a := vector.AxisAngle{Axis: vec{1, 1, 1}, Angle: 2 * math.Pi / 3}
+
+	fmt.Println(
+		vec{1, 0, 0}.RotateByAxisAngle(a),
+	)
+	// Output: [0 1 0]
+}
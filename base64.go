@@ -0,0 +1,70 @@
+package vector
+
+import (
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"math"
+)
+
+var (
+	// ErrInvalidBase64 is returned when a string can not be decoded by
+	// DecodeBase64
+	ErrInvalidBase64 = errors.New("invalid base64 vector encoding")
+)
+
+// EncodeBase64 encodes a set of vectors into a compact string that is safe
+// to use in urls and json. Each vector is stored as its dimension as a varint
+// followed by the scalars as 32 bit floats, which means precision beyond a
+// float32 is lost.
+func EncodeBase64(vs []Vector) string {
+	size := 0
+
+	for i := range vs {
+		size += binary.MaxVarintLen64 + 4*len(vs[i])
+	}
+
+	buf := make([]byte, 0, size)
+	tmp := make([]byte, binary.MaxVarintLen64)
+
+	for _, v := range vs {
+		buf = append(buf, tmp[:binary.PutUvarint(tmp, uint64(len(v)))]...)
+
+		for _, scalar := range v {
+			buf = appendUint32(buf, math.Float32bits(float32(scalar)))
+		}
+	}
+
+	return base64.RawURLEncoding.EncodeToString(buf)
+}
+
+// DecodeBase64 decodes a string created by EncodeBase64
+func DecodeBase64(s string) ([]Vector, error) {
+	buf, err := base64.RawURLEncoding.DecodeString(s)
+
+	if err != nil {
+		return nil, ErrInvalidBase64
+	}
+
+	vs := []Vector{}
+
+	for len(buf) > 0 {
+		dim, n := binary.Uvarint(buf)
+
+		if n <= 0 || dim > uint64(len(buf)-n)/4 {
+			return nil, ErrInvalidBase64
+		}
+
+		buf = buf[n:]
+		v := make(Vector, dim)
+
+		for i := range v {
+			v[i] = float64(math.Float32frombits(binary.LittleEndian.Uint32(buf[4*i:])))
+		}
+
+		vs = append(vs, v)
+		buf = buf[4*dim:]
+	}
+
+	return vs, nil
+}
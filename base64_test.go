@@ -0,0 +1,51 @@
+package vector_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/kvartborg/vector"
+)
+
+func TestBase64RoundTrip(t *testing.T) {
+	vs := []vec{{1, 2, 3}, {}, {0.5, -4}}
+	result, err := vector.DecodeBase64(vector.EncodeBase64(vs))
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(result) != len(vs) {
+		t.Fatalf("expected %d vectors, got %d", len(vs), len(result))
+	}
+
+	for i := range vs {
+		if !result[i].Equal(vs[i]) {
+			t.Errorf("expected %v, got %v", vs[i], result[i])
+		}
+	}
+}
+
+func TestBase64Invalid(t *testing.T) {
+	s := vector.EncodeBase64([]vec{{1, 2, 3}})
+
+	for _, invalid := range []string{"!!", s[:len(s)-3]} {
+		if _, err := vector.DecodeBase64(invalid); err != vector.ErrInvalidBase64 {
+			t.Errorf("expected %q to be rejected", invalid)
+		}
+	}
+}
+
+func ExampleEncodeBase64() {
+	fmt.Println(
+		vector.EncodeBase64([]vec{{1, 2}}),
+	)
+	// Output: AgAAgD8AAABA
+}
+
+func ExampleDecodeBase64() {
+	fmt.Println(
+		vector.DecodeBase64("AgAAgD8AAABA"),
+	)
+	// Output: [[1 2]] <nil>
+}
@@ -0,0 +1,90 @@
+package vector
+
+// ToLocal converts a point in world space into the local space of a
+// coordinate frame, defined by its origin and a set of orthogonal basis
+// vectors. Each component of the result is the coordinate along the
+// corresponding basis vector, so the result has one dimension per basis
+// vector. Directions can be converted by passing a nil origin.
+//
+// If no basis is given, the standard basis is used and the point is only
+// translated.
+func (v Vector) ToLocal(origin Vector, basis ...Vector) Vector {
+	d := Sub(v, origin)
+
+	if len(basis) == 0 {
+		return d
+	}
+
+	local := make(Vector, len(basis))
+
+	for i, b := range basis {
+		if l := Dot(b, b); l > 1e-16 {
+			local[i] = Dot(d, b) / l
+		}
+	}
+
+	return local
+}
+
+// ToWorld converts a point in the local space of a coordinate frame back
+// into world space, it is the inverse of ToLocal. Directions can be
+// converted by passing a nil origin.
+func (v Vector) ToWorld(origin Vector, basis ...Vector) Vector {
+	if len(basis) == 0 {
+		return Add(v, origin)
+	}
+
+	dim := len(origin)
+
+	for i := range basis {
+		if len(basis[i]) > dim {
+			dim = len(basis[i])
+		}
+	}
+
+	world := make(Vector, dim)
+	world.Add(origin)
+
+	for i, b := range basis {
+		axpyUnitaryTo(world, at(v, i), b, world)
+	}
+
+	return world
+}
+
+// TBN is an orthonormal tangent space basis of a surface, made of the
+// tangent, bitangent and normal vectors
+type TBN struct {
+	Tangent, Bitangent, Normal Vector
+}
+
+// NewTBN creates a tangent space basis from a surface normal and tangent.
+// The tangent is made orthogonal to the normal and the bitangent is the
+// cross product of the normal and the tangent.
+func NewTBN(normal, tangent Vector) (TBN, error) {
+	if len(normal) != 3 || len(tangent) != 3 {
+		return TBN{}, ErrNot3Dimensional
+	}
+
+	n := Unit(normal)
+	t := Sub(tangent, Scale(n, Dot(tangent, n)))
+
+	if n.Magnitude() < 1e-8 || t.Magnitude() < 1e-8 {
+		return TBN{}, ErrZeroVector
+	}
+
+	t.Unit()
+	b, _ := Cross(n, t)
+
+	return TBN{t, b, n}, nil
+}
+
+// ToTangent converts a direction in world space into tangent space
+func (t TBN) ToTangent(v Vector) Vector {
+	return v.ToLocal(nil, t.Tangent, t.Bitangent, t.Normal)
+}
+
+// ToWorld converts a direction in tangent space into world space
+func (t TBN) ToWorld(v Vector) Vector {
+	return v.ToWorld(nil, t.Tangent, t.Bitangent, t.Normal)
+}
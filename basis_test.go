@@ -0,0 +1,76 @@
+package vector_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/kvartborg/vector"
+)
+
+func TestLocalWorldRoundTrip(t *testing.T) {
+	origin := vec{1, 2, 3}
+	basis := []vec{{0, 1, 0}, {-2, 0, 0}, {0, 0, 1}}
+	point := vec{4, -1, 7}
+
+	local := point.ToLocal(origin, basis...)
+
+	if !local.Equal(vec{-3, -1.5, 4}) {
+		t.Errorf("expected [-3 -1.5 4], got %v", local)
+	}
+
+	if result := local.ToWorld(origin, basis...); !result.Equal(point) {
+		t.Errorf("expected %v, got %v", point, result)
+	}
+}
+
+func ExampleVector_ToLocal() {
+	// a frame rotated 90 degrees around the z axis
+	x, y := vec{0, 1}, vec{-1, 0}
+
+	fmt.Println(
+		vec{3, 3}.ToLocal(vec{1, 1}, x, y),
+		vec{1, 0}.ToLocal(nil, x, y),
+	)
+	// Output: [2 -2] [0 -1]
+}
+
+func ExampleVector_ToWorld() {
+	x, y := vec{0, 1}, vec{-1, 0}
+
+	fmt.Println(
+		vec{2, -2}.ToWorld(vec{1, 1}, x, y),
+	)
+	// Output: [3 3]
+}
+
+func TestTBN(t *testing.T) {
+	tbn, err := vector.NewTBN(vec{0, 2, 0}, vec{1, 1, 0})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !tbn.Tangent.Equal(vec{1, 0, 0}) || !tbn.Bitangent.Equal(vec{0, 0, -1}) || !tbn.Normal.Equal(vec{0, 1, 0}) {
+		t.Errorf("did not create an orthonormal basis, got %v", tbn)
+	}
+
+	v := vec{0.5, -2, 3}
+
+	if result := tbn.ToWorld(tbn.ToTangent(v)); !result.Equal(v) {
+		t.Errorf("expected %v, got %v", v, result)
+	}
+
+	if _, err := vector.NewTBN(vec{0, 1, 0}, vec{0, 3, 0}); err != vector.ErrZeroVector {
+		t.Error("expected tangent parallel to the normal to be rejected")
+	}
+}
+
+func ExampleTBN_ToWorld() {
+	tbn, _ := vector.NewTBN(vec{0, 0, 1}, vec{1, 0, 0})
+
+	// a normal map sample pointing straight out of the surface
+	fmt.Println(
+		tbn.ToWorld(vec{0, 0, 1}),
+	)
+	// Output: [0 0 1]
+}
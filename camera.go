@@ -0,0 +1,105 @@
+package vector
+
+import (
+	"errors"
+	"math"
+)
+
+var (
+	// ErrNotProjectable is returned when a point ends up with a homogeneous w
+	// component of 0 and can not be mapped to or from the screen
+	ErrNotProjectable = errors.New("point can not be projected")
+)
+
+// ScreenToNDC converts pixel coordinates of a viewport with the given width
+// and height into normalized device coordinates. The origin of the screen is
+// the top left corner and the optional z component is a depth in the range
+// [0, 1], which is mapped to [-1, 1].
+func ScreenToNDC(screen Vector, width, height float64) Vector {
+	return Vector{
+		2*screen.X()/width - 1,
+		1 - 2*screen.Y()/height,
+		2*screen.Z() - 1,
+	}
+}
+
+// NDCToScreen converts normalized device coordinates into pixel coordinates
+// of a viewport with the given width and height, it is the inverse of
+// ScreenToNDC.
+func NDCToScreen(ndc Vector, width, height float64) Vector {
+	return Vector{
+		(ndc.X() + 1) * width / 2,
+		(1 - ndc.Y()) * height / 2,
+		(ndc.Z() + 1) / 2,
+	}
+}
+
+// WorldToScreen projects a point in world space into pixel coordinates of a
+// viewport with the given width and height, viewProj is the combined view
+// and projection matrix of the camera.
+func WorldToScreen(world Vector, viewProj Matrix4, width, height float64) (Vector, error) {
+	x, y, z, w := viewProj.mul4(world.X(), world.Y(), world.Z(), 1)
+
+	if math.Abs(w) < 1e-8 {
+		return nil, ErrNotProjectable
+	}
+
+	return NDCToScreen(Vector{x / w, y / w, z / w}, width, height), nil
+}
+
+// ScreenToWorld unprojects pixel coordinates of a viewport with the given
+// width and height back into world space, it is the inverse of
+// WorldToScreen. The z component of the screen vector selects the depth
+// between the near plane at 0 and the far plane at 1.
+func ScreenToWorld(screen Vector, viewProj Matrix4, width, height float64) (Vector, error) {
+	inv, err := viewProj.Inverse()
+
+	if err != nil {
+		return nil, err
+	}
+
+	return unprojectNDC(ScreenToNDC(screen, width, height), inv)
+}
+
+// unprojectNDC transforms normalized device coordinates into world space
+// using the inverse of the view projection matrix
+func unprojectNDC(ndc Vector, inv Matrix4) (Vector, error) {
+	x, y, z, w := inv.mul4(ndc[X], ndc[Y], ndc[Z], 1)
+
+	if math.Abs(w) < 1e-8 {
+		return nil, ErrNotProjectable
+	}
+
+	return Vector{x / w, y / w, z / w}, nil
+}
+
+// Unproject converts a position on the screen, like the mouse cursor, into a
+// ray in world space that starts at the near plane of the camera and points
+// away from it. The viewport is the area of the screen in pixels that the
+// camera renders to, viewProj is the combined view and projection matrix of
+// the camera.
+func Unproject(screen Vector, viewProj Matrix4, viewport AABB) (Ray, error) {
+	inv, err := viewProj.Inverse()
+
+	if err != nil {
+		return Ray{}, err
+	}
+
+	width := viewport.Max.X() - viewport.Min.X()
+	height := viewport.Max.Y() - viewport.Min.Y()
+	x, y := screen.X()-viewport.Min.X(), screen.Y()-viewport.Min.Y()
+
+	near, err := unprojectNDC(ScreenToNDC(Vector{x, y, 0}, width, height), inv)
+
+	if err != nil {
+		return Ray{}, err
+	}
+
+	far, err := unprojectNDC(ScreenToNDC(Vector{x, y, 1}, width, height), inv)
+
+	if err != nil {
+		return Ray{}, err
+	}
+
+	return Ray{near, far.Sub(near).Unit()}, nil
+}
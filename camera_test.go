@@ -0,0 +1,84 @@
+package vector_test
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/kvartborg/vector"
+)
+
+func TestWorldToScreenRoundTrip(t *testing.T) {
+	proj := vector.Perspective(math.Pi/3, 16./9, 0.1, 100)
+	world := vec{1, -2, -10}
+
+	screen, err := vector.WorldToScreen(world, proj, 1920, 1080)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	result, err := vector.ScreenToWorld(screen, proj, 1920, 1080)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if vector.Sub(result, world).Magnitude() > 1e-6 {
+		t.Errorf("expected %v, got %v", world, result)
+	}
+}
+
+func TestWorldToScreenNotProjectable(t *testing.T) {
+	proj := vector.Perspective(math.Pi/3, 1, 0.1, 100)
+
+	if _, err := vector.WorldToScreen(vec{1, 1, 0}, proj, 100, 100); err != vector.ErrNotProjectable {
+		t.Error("expected point on the camera plane to be rejected")
+	}
+}
+
+func ExampleScreenToNDC() {
+	fmt.Println(
+		vector.ScreenToNDC(vec{0, 0}, 800, 600),
+		vector.ScreenToNDC(vec{400, 300, 1}, 800, 600),
+	)
+	// Output: [-1 1 -1] [0 0 1]
+}
+
+func ExampleNDCToScreen() {
+	fmt.Println(
+		vector.NDCToScreen(vec{1, -1, 1}, 800, 600),
+	)
+	// Output: [800 600 1]
+}
+
+func ExampleWorldToScreen() {
+	proj := vector.Orthographic(-10, 10, -10, 10, -1, 1)
+
+	fmt.Println(
+		vector.WorldToScreen(vec{5, 5, 0}, proj, 200, 200),
+	)
+	// Output: [150 50 0.5] <nil>
+}
+
+func TestUnproject(t *testing.T) {
+	proj := vector.Perspective(math.Pi/2, 1, 1, 100)
+	view, _ := vector.LookAt(vec{0, 0, 10}, vec{0, 0, 0}, vec{0, 1, 0})
+	viewport := vector.AABB{Min: vec{100, 100}, Max: vec{300, 300}}
+
+	ray, err := vector.Unproject(vec{200, 200}, proj.Mul(view), viewport)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !ray.Origin.Equal(vec{0, 0, 9}) || !ray.Direction.Equal(vec{0, 0, -1}) {
+		t.Errorf("expected ray through the center of the viewport, got %v", ray)
+	}
+
+	ray, _ = vector.Unproject(vec{300, 100}, proj.Mul(view), viewport)
+
+	if !ray.Origin.Equal(vec{1, 1, 9}) {
+		t.Errorf("expected ray through the top right corner, got %v", ray)
+	}
+}
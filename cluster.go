@@ -0,0 +1,154 @@
+package vector
+
+import (
+	"errors"
+	"math"
+	"math/rand"
+)
+
+var (
+	// ErrClusterCount is returned when the number of clusters is less than 1
+	// or larger than the number of vectors
+	ErrClusterCount = errors.New("invalid number of clusters")
+)
+
+// Option configures the KMeans clustering, like KMeansIterations and
+// KMeansSeed
+type Option func(*kmeansConfig)
+
+type kmeansConfig struct {
+	iterations int
+	seed       int64
+}
+
+// KMeansIterations sets the max number of iterations of KMeans, the default
+// is 100
+func KMeansIterations(n int) Option {
+	return func(c *kmeansConfig) {
+		c.iterations = n
+	}
+}
+
+// KMeansSeed sets the seed used to pick the initial centroids of KMeans, the
+// default seed is 1 which makes the clustering deterministic
+func KMeansSeed(seed int64) Option {
+	return func(c *kmeansConfig) {
+		c.seed = seed
+	}
+}
+
+// KMeans groups a set of vectors into k clusters, it returns the centroid of
+// each cluster and the index of the cluster each vector is assigned to. The
+// initial centroids are picked with k-means++ and the clusters are refined
+// until the assignments stop changing or the max number of iterations is
+// reached.
+func KMeans(vs []Vector, k int, opts ...Option) ([]Vector, []int, error) {
+	if err := checkDimensions(vs); err != nil {
+		return nil, nil, err
+	}
+
+	if k < 1 || k > len(vs) {
+		return nil, nil, ErrClusterCount
+	}
+
+	config := kmeansConfig{iterations: 100, seed: 1}
+
+	for _, opt := range opts {
+		opt(&config)
+	}
+
+	centroids := kmeansPlusPlus(vs, k, rand.New(rand.NewSource(config.seed)))
+	assignments := make([]int, len(vs))
+
+	for i := range assignments {
+		assignments[i] = -1
+	}
+
+	for n := 0; n < config.iterations; n++ {
+		changed := false
+
+		for i, v := range vs {
+			if c := nearest(v, centroids); c != assignments[i] {
+				assignments[i] = c
+				changed = true
+			}
+		}
+
+		if !changed {
+			break
+		}
+
+		sums := make([]Vector, k)
+		counts := make([]int, k)
+
+		for i, v := range vs {
+			c := assignments[i]
+
+			if sums[c] == nil {
+				sums[c] = make(Vector, len(v))
+			}
+
+			sums[c].Add(v)
+			counts[c]++
+		}
+
+		// empty clusters keeps their previous centroid
+		for c := range centroids {
+			if counts[c] > 0 {
+				centroids[c] = sums[c].Scale(1 / float64(counts[c]))
+			}
+		}
+	}
+
+	return centroids, assignments, nil
+}
+
+// kmeansPlusPlus picks k initial centroids, each new centroid is picked with
+// a probability proportional to its squared distance to the closest centroid
+// already picked
+func kmeansPlusPlus(vs []Vector, k int, r *rand.Rand) []Vector {
+	centroids := []Vector{vs[r.Intn(len(vs))].Clone()}
+	distances := make([]float64, len(vs))
+
+	for len(centroids) < k {
+		total := 0.
+
+		for i, v := range vs {
+			d := Sub(v, centroids[nearest(v, centroids)])
+			distances[i] = Dot(d, d)
+			total += distances[i]
+		}
+
+		pick := r.Intn(len(vs))
+
+		if total > 0 {
+			target := r.Float64() * total
+
+			for i, d := range distances {
+				if target -= d; target < 0 {
+					pick = i
+					break
+				}
+			}
+		}
+
+		centroids = append(centroids, vs[pick].Clone())
+	}
+
+	return centroids
+}
+
+// nearest returns the index of the centroid closest to v
+func nearest(v Vector, centroids []Vector) int {
+	best, min := 0, math.Inf(1)
+
+	for i, c := range centroids {
+		d := Sub(v, c)
+
+		if dist := Dot(d, d); dist < min {
+			best, min = i, dist
+		}
+	}
+
+	return best
+}
@@ -0,0 +1,52 @@
+package vector_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/kvartborg/vector"
+)
+
+func TestKMeansErrors(t *testing.T) {
+	if _, _, err := vector.KMeans([]vec{{1}, {2}}, 3); err != vector.ErrClusterCount {
+		t.Errorf("expected ErrClusterCount, got %v", err)
+	}
+
+	if _, _, err := vector.KMeans(nil, 1); err != vector.ErrNoVectors {
+		t.Errorf("expected ErrNoVectors, got %v", err)
+	}
+}
+
+func TestKMeansDeterministic(t *testing.T) {
+	vs := []vec{{0, 0}, {1, 0}, {5, 5}, {6, 5}, {0, 9}, {1, 9}, {3, 3}}
+
+	for seed := int64(0); seed < 10; seed++ {
+		c1, a1, _ := vector.KMeans(vs, 3, vector.KMeansSeed(seed))
+		c2, a2, _ := vector.KMeans(vs, 3, vector.KMeansSeed(seed))
+
+		for i := range c1 {
+			if !c1[i].Equal(c2[i]) {
+				t.Fatalf("expected the same centroids for seed %d", seed)
+			}
+		}
+
+		for i := range a1 {
+			if a1[i] != a2[i] {
+				t.Fatalf("expected the same assignments for seed %d", seed)
+			}
+		}
+	}
+}
+
+func ExampleKMeans() {
+	vs := []vec{{0, 0}, {0, 2}, {10, 10}, {10, 12}}
+	centroids, assignments, _ := vector.KMeans(vs, 2, vector.KMeansIterations(10))
+
+	fmt.Println(
+		centroids[assignments[0]],
+		centroids[assignments[2]],
+		assignments[0] == assignments[1],
+		assignments[2] == assignments[3],
+	)
+	// Output: [0 1] [10 11] true true
+}
@@ -0,0 +1,58 @@
+package vector
+
+// Columns transposes a set of vectors into one column per axis, which is the
+// layout used by columnar formats like Apache Arrow and Parquet. Each column
+// can be appended directly to a float64 column builder of those libraries,
+// or EncodeArrow can be used to write the columns as an Arrow stream.
+// The number of columns is the highest dimension in the set, vectors with a
+// lower dimension are padded with 0.
+func Columns(vs []Vector) [][]float64 {
+	dim := 0
+
+	for i := range vs {
+		if len(vs[i]) > dim {
+			dim = len(vs[i])
+		}
+	}
+
+	columns := make([][]float64, dim)
+	data := make([]float64, dim*len(vs))
+
+	for axis := range columns {
+		columns[axis] = data[axis*len(vs) : (axis+1)*len(vs)]
+
+		for i := range vs {
+			columns[axis][i] = at(vs[i], axis)
+		}
+	}
+
+	return columns
+}
+
+// FromColumns is the inverse of Columns, it creates a vector per row of the
+// columns
+func FromColumns(columns [][]float64) ([]Vector, error) {
+	if len(columns) == 0 {
+		return []Vector{}, nil
+	}
+
+	rows := len(columns[0])
+
+	for i := range columns {
+		if len(columns[i]) != rows {
+			return nil, ErrLengthMismatch
+		}
+	}
+
+	vs := make([]Vector, rows)
+
+	for i := range vs {
+		vs[i] = make(Vector, len(columns))
+
+		for axis := range columns {
+			vs[i][axis] = columns[axis][i]
+		}
+	}
+
+	return vs, nil
+}
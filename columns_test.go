@@ -0,0 +1,28 @@
+package vector_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/kvartborg/vector"
+)
+
+func TestFromColumnsLength(t *testing.T) {
+	if _, err := vector.FromColumns([][]float64{{1, 2}, {3}}); err != vector.ErrLengthMismatch {
+		t.Error("expected columns of different length to be rejected")
+	}
+}
+
+func ExampleColumns() {
+	fmt.Println(
+		vector.Columns([]vec{{1, 2, 3}, {4, 5}}),
+	)
+	// Output: [[1 4] [2 5] [3 0]]
+}
+
+func ExampleFromColumns() {
+	fmt.Println(
+		vector.FromColumns([][]float64{{1, 4}, {2, 5}}),
+	)
+	// Output: [[1 2] [4 5]] <nil>
+}
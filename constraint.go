@@ -0,0 +1,154 @@
+package vector
+
+// Stick is a distance constraint that keeps two points of a VerletSystem,
+// given by their index, at a fixed length from each other
+type Stick struct {
+	A, B   int
+	Length float64
+}
+
+// VerletSystem is a set of particles connected by sticks, it is simulated
+// with Verlet integration and can be used for ropes, chains and cloth.
+// Points can be moved directly, pinned points are not moved by the
+// simulation which allows them to be attached to other objects.
+type VerletSystem struct {
+	Points []Vector
+	Sticks []Stick
+	Pinned []bool
+
+	// Gravity is the acceleration applied to every point that is not pinned
+	Gravity Vector
+
+	// Iterations is the number of times the sticks are solved each step,
+	// more iterations makes the sticks stiffer
+	Iterations int
+
+	previous []Vector
+}
+
+// NewVerletSystem creates a system of particles at rest at the given
+// points, without any sticks
+func NewVerletSystem(points ...Vector) *VerletSystem {
+	s := &VerletSystem{
+		Points:     make([]Vector, len(points)),
+		Pinned:     make([]bool, len(points)),
+		Iterations: 8,
+		previous:   make([]Vector, len(points)),
+	}
+
+	for i, p := range points {
+		s.Points[i] = p.Clone()
+		s.previous[i] = p.Clone()
+	}
+
+	return s
+}
+
+// NewRope creates a system of segments + 1 points on a line from start to
+// end, connected by sticks, with the first point pinned
+func NewRope(start, end Vector, segments int) *VerletSystem {
+	if segments < 1 {
+		segments = 1
+	}
+
+	points := make([]Vector, segments+1)
+
+	for i := range points {
+		points[i] = AddScaled(start, Sub(end, start), float64(i)/float64(segments))
+	}
+
+	s := NewVerletSystem(points...)
+
+	for i := 0; i < segments; i++ {
+		s.Connect(i, i+1)
+	}
+
+	s.Pin(0)
+	return s
+}
+
+// Connect adds a stick between two points, the length of the stick is the
+// current distance between them
+func (s *VerletSystem) Connect(a, b int) {
+	length := Sub(s.Points[b], s.Points[a]).Magnitude()
+	s.Sticks = append(s.Sticks, Stick{a, b, length})
+}
+
+// Pin locks a point in place
+func (s *VerletSystem) Pin(i int) {
+	s.grow()
+	s.Pinned[i] = true
+}
+
+// Unpin releases a pinned point
+func (s *VerletSystem) Unpin(i int) {
+	s.grow()
+	s.Pinned[i] = false
+	s.previous[i] = s.Points[i].Clone()
+}
+
+// Step advances the simulation by a time step of dt. Points added after the
+// system was created start at rest and are not pinned.
+func (s *VerletSystem) Step(dt float64) {
+	s.grow()
+
+	for i, p := range s.Points {
+		if s.Pinned[i] {
+			s.previous[i] = p.Clone()
+			continue
+		}
+
+		velocity := Sub(p, s.previous[i])
+		s.previous[i] = p.Clone()
+		p.Add(velocity).Add(Scale(s.Gravity, dt*dt))
+	}
+
+	for k := 0; k < s.Iterations; k++ {
+		for _, stick := range s.Sticks {
+			s.solve(stick)
+		}
+	}
+}
+
+// grow extends the pinned and previous state to points that were added
+// directly to Points, the new points start at rest
+func (s *VerletSystem) grow() {
+	for len(s.previous) < len(s.Points) {
+		s.previous = append(s.previous, s.Points[len(s.previous)].Clone())
+	}
+
+	for len(s.Pinned) < len(s.Points) {
+		s.Pinned = append(s.Pinned, false)
+	}
+}
+
+// solve moves the points of a stick so they are at the length of the stick,
+// points that are not pinned share the correction equally
+func (s *VerletSystem) solve(stick Stick) {
+	a, b := s.Points[stick.A], s.Points[stick.B]
+	pinnedA, pinnedB := s.Pinned[stick.A], s.Pinned[stick.B]
+
+	if pinnedA && pinnedB {
+		return
+	}
+
+	delta := Sub(b, a)
+	d := delta.Magnitude()
+
+	if d < 1e-12 {
+		return
+	}
+
+	delta.Scale((d - stick.Length) / d)
+
+	switch {
+	case pinnedA:
+		b.Sub(delta)
+	case pinnedB:
+		a.Add(delta)
+	default:
+		delta.Scale(0.5)
+		a.Add(delta)
+		b.Sub(delta)
+	}
+}
@@ -0,0 +1,85 @@
+package vector_test
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/kvartborg/vector"
+)
+
+func TestRopeHangsFromPin(t *testing.T) {
+	rope := vector.NewRope(vec{0, 0}, vec{4, 0}, 4)
+	rope.Gravity = vec{0, -10}
+	rope.Iterations = 20
+
+	for i := 0; i < 2000; i++ {
+		rope.Step(1. / 60)
+	}
+
+	if !rope.Points[0].Equal(vec{0, 0}) {
+		t.Errorf("expected the pinned point to stay in place, got %v", rope.Points[0])
+	}
+
+	end := rope.Points[4]
+
+	if end.Y() > -3.5 || math.Abs(end.X()) > 0.5 {
+		t.Errorf("expected the rope to hang straight down, end at %v", end)
+	}
+
+	for _, s := range rope.Sticks {
+		l := vector.Sub(rope.Points[s.B], rope.Points[s.A]).Magnitude()
+
+		if math.Abs(l-s.Length) > 0.05 {
+			t.Errorf("expected stick length %v, got %v", s.Length, l)
+		}
+	}
+}
+
+func TestVerletSystemStick(t *testing.T) {
+	s := vector.NewVerletSystem(vec{0, 0}, vec{2, 0})
+	s.Sticks = append(s.Sticks, vector.Stick{A: 0, B: 1, Length: 1})
+	s.Step(1)
+
+	if !s.Points[0].Equal(vec{0.5, 0}) || !s.Points[1].Equal(vec{1.5, 0}) {
+		t.Errorf("expected both points to move equally, got %v", s.Points)
+	}
+
+	s.Pin(0)
+	s.Sticks[0].Length = 2
+	s.Step(1)
+
+	if !s.Points[0].Equal(vec{0.5, 0}) || !s.Points[1].Equal(vec{2.5, 0}) {
+		t.Errorf("expected only the free point to move, got %v", s.Points)
+	}
+}
+
+func TestVerletSystemAddPoint(t *testing.T) {
+	s := vector.NewVerletSystem(vec{0, 0})
+	s.Points = append(s.Points, vec{1, 0})
+	s.Connect(0, 1)
+	s.Pin(1)
+	s.Step(1)
+
+	if !s.Points[1].Equal(vec{1, 0}) {
+		t.Errorf("expected the added point to start at rest, got %v", s.Points[1])
+	}
+
+	literal := vector.VerletSystem{Points: []vec{{0, 0}}, Gravity: vec{0, -1}}
+	literal.Step(1)
+
+	if !literal.Points[0].Equal(vec{0, -1}) {
+		t.Errorf("expected a system created without NewVerletSystem to step, got %v", literal.Points)
+	}
+}
+
+func ExampleVerletSystem_Step() {
+	s := vector.NewVerletSystem(vec{0, 0}, vec{1, 0})
+	s.Connect(0, 1)
+	s.Pin(0)
+	s.Points[1] = vec{3, 0}
+	s.Step(1)
+
+	fmt.Println(s.Points)
+	// Output: [[0 0] [1 0]]
+}
@@ -0,0 +1,93 @@
+package vector
+
+// Convention is an enum type that describes the up axis and handedness of a
+// 3-dimensional coordinate system
+type Convention int
+
+const (
+	// YUpRightHanded is used by OpenGL, glTF, Godot and Maya
+	YUpRightHanded Convention = iota
+	// ZUpRightHanded is used by Blender and 3ds Max
+	ZUpRightHanded
+	// YUpLeftHanded is used by Unity and DirectX
+	YUpLeftHanded
+	// ZUpLeftHanded is used by Unreal Engine, with x forward and y right
+	ZUpLeftHanded
+)
+
+// signedAxis maps an axis of YUpRightHanded to an axis of another convention
+type signedAxis struct {
+	axis Axis
+	sign float64
+}
+
+// conventions holds the axis of each convention that maps to the x, y and z
+// axis of YUpRightHanded
+var conventions = map[Convention][3]signedAxis{
+	YUpRightHanded: {{X, 1}, {Y, 1}, {Z, 1}},
+	ZUpRightHanded: {{X, 1}, {Z, 1}, {Y, -1}},
+	YUpLeftHanded:  {{X, 1}, {Y, 1}, {Z, -1}},
+	ZUpLeftHanded:  {{Y, 1}, {Z, 1}, {X, -1}},
+}
+
+// ConvertAxes converts a vector from one axis convention to another
+func ConvertAxes(v Vector, from, to Convention) Vector {
+	return v.Clone().ConvertAxes(from, to)
+}
+
+// ConvertAxes converts a vector from one axis convention to another.
+//
+// If a vector with less than 3 dimensions is converted, it will be extended
+// to 3 dimensions. Dimensions beyond the third are left untouched.
+func (v Vector) ConvertAxes(from, to Convention) Vector {
+	for len(v) < 3 {
+		v = append(v, 0)
+	}
+
+	var canonical, result [3]float64
+
+	for i, a := range conventions[from] {
+		canonical[i] = a.sign * v[a.axis]
+	}
+
+	for i, a := range conventions[to] {
+		result[a.axis] = a.sign * canonical[i]
+	}
+
+	copy(v, result[:])
+	return v
+}
+
+// ConvertAxesSlice returns a new slice with all vectors converted from one
+// axis convention to another
+func ConvertAxesSlice(vs []Vector, from, to Convention) []Vector {
+	result := make([]Vector, len(vs))
+
+	for i := range vs {
+		result[i] = ConvertAxes(vs[i], from, to)
+	}
+
+	return result
+}
+
+// ConvertAxes converts a transformation matrix from one axis convention to
+// another, so it can be applied to vectors converted with the same
+// conventions
+func (m Matrix4) ConvertAxes(from, to Convention) Matrix4 {
+	var c, inv Matrix4
+	c[15], inv[15] = 1, 1
+
+	// the conversion is a signed permutation, so its inverse is the transpose
+	for col := 0; col < 3; col++ {
+		basis := make(Vector, 3)
+		basis[col] = 1
+		basis.ConvertAxes(from, to)
+
+		for row := 0; row < 3; row++ {
+			c[row*4+col] = basis[row]
+			inv[col*4+row] = basis[row]
+		}
+	}
+
+	return c.Mul(m).Mul(inv)
+}
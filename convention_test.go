@@ -0,0 +1,84 @@
+package vector_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/kvartborg/vector"
+)
+
+func TestConvertAxesRoundTrip(t *testing.T) {
+	conventions := []vector.Convention{
+		vector.YUpRightHanded,
+		vector.ZUpRightHanded,
+		vector.YUpLeftHanded,
+		vector.ZUpLeftHanded,
+	}
+	v := vec{1, 2, 3, 4}
+
+	for _, from := range conventions {
+		for _, to := range conventions {
+			result := vector.ConvertAxes(v, from, to).ConvertAxes(to, from)
+
+			if !result.Equal(v) {
+				t.Errorf("expected %v, got %v when converting from %d to %d", v, result, from, to)
+			}
+		}
+	}
+}
+
+func TestConvertAxesUnreal(t *testing.T) {
+	// right, up and forward in YUpRightHanded and in Unreal Engine
+	tests := []struct {
+		v, expected vec
+	}{
+		{vec{1, 0, 0}, vec{0, 1, 0}},
+		{vec{0, 1, 0}, vec{0, 0, 1}},
+		{vec{0, 0, -1}, vec{1, 0, 0}},
+	}
+
+	for _, test := range tests {
+		result := vector.ConvertAxes(test.v, vector.YUpRightHanded, vector.ZUpLeftHanded)
+
+		if !result.Equal(test.expected) {
+			t.Errorf("expected %v, got %v", test.expected, result)
+		}
+
+		if back := result.ConvertAxes(vector.ZUpLeftHanded, vector.YUpRightHanded); !back.Equal(test.v) {
+			t.Errorf("expected %v, got %v", test.v, back)
+		}
+	}
+}
+
+func TestMatrix4ConvertAxes(t *testing.T) {
+	// scale x by 2 and z by 3 in a y-up convention
+	m := vector.Matrix4{
+		2, 0, 0, 0,
+		0, 1, 0, 0,
+		0, 0, 3, 0,
+		0, 0, 0, 1,
+	}
+
+	result := m.ConvertAxes(vector.YUpRightHanded, vector.ZUpRightHanded)
+	p := result.MulVec(vector.ConvertAxes(vec{1, 1, 1, 1}, vector.YUpRightHanded, vector.ZUpRightHanded))
+	expected := vector.ConvertAxes(m.MulVec(vec{1, 1, 1, 1}), vector.YUpRightHanded, vector.ZUpRightHanded)
+
+	if !p.Equal(expected) {
+		t.Errorf("expected %v, got %v", expected, p)
+	}
+}
+
+func ExampleConvertAxes() {
+	fmt.Println(
+		vector.ConvertAxes(vec{1, 2, 3}, vector.ZUpRightHanded, vector.YUpRightHanded),
+		vector.ConvertAxes(vec{1, 2, 3}, vector.YUpRightHanded, vector.YUpLeftHanded),
+	)
+	// Output: [1 3 -2] [1 2 -3]
+}
+
+func ExampleConvertAxesSlice() {
+	fmt.Println(
+		vector.ConvertAxesSlice([]vec{{1, 2, 3}, {4, 5, 6}}, vector.ZUpLeftHanded, vector.YUpRightHanded),
+	)
+	// Output: [[2 3 -1] [5 6 -4]]
+}
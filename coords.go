@@ -0,0 +1,90 @@
+package vector
+
+import "math"
+
+// FromPolar creates a 2-dimensional vector from polar coordinates, where r
+// is the length of the vector and theta is the angle in radians
+// counterclockwise from the x axis
+func FromPolar(r, theta float64) Vector {
+	sin, cos := math.Sincos(theta)
+	return Vector{r * cos, r * sin}
+}
+
+// ToPolar returns the polar coordinates of the x and y components of the
+// vector, theta is in the range [-π, π]
+func (v Vector) ToPolar() (r, theta float64) {
+	x, y := v.X(), v.Y()
+	return math.Hypot(x, y), math.Atan2(y, x)
+}
+
+// FromSpherical creates a 3-dimensional vector from spherical coordinates
+// using the physics convention, r is the length of the vector, theta is the
+// polar angle in radians from the z axis and phi is the azimuthal angle in
+// radians counterclockwise from the x axis in the xy plane
+func FromSpherical(r, theta, phi float64) Vector {
+	sinTheta, cosTheta := math.Sincos(theta)
+	sinPhi, cosPhi := math.Sincos(phi)
+	return Vector{r * sinTheta * cosPhi, r * sinTheta * sinPhi, r * cosTheta}
+}
+
+// ToSpherical returns the spherical coordinates of the vector using the same
+// convention as FromSpherical, theta is in the range [0, π] and phi is in the
+// range [-π, π]
+func (v Vector) ToSpherical() (r, theta, phi float64) {
+	x, y, z := v.X(), v.Y(), v.Z()
+	return math.Sqrt(x*x + y*y + z*z), math.Atan2(math.Hypot(x, y), z), math.Atan2(y, x)
+}
+
+// FromCylindrical creates a 3-dimensional vector from cylindrical
+// coordinates, r is the distance from the z axis, theta is the angle in
+// radians counterclockwise from the x axis and z is the height along the z
+// axis
+func FromCylindrical(r, theta, z float64) Vector {
+	sin, cos := math.Sincos(theta)
+	return Vector{r * cos, r * sin, z}
+}
+
+// ToCylindrical returns the cylindrical coordinates of the vector using the
+// same convention as FromCylindrical, theta is in the range [-π, π]
+func (v Vector) ToCylindrical() (r, theta, z float64) {
+	r, theta = v.ToPolar()
+	return r, theta, v.Z()
+}
+
+// ToHomogeneous returns a new vector in homogeneous coordinates by appending
+// a w component of 1 to the vector
+func (v Vector) ToHomogeneous() Vector {
+	return append(v.Clone(), 1)
+}
+
+// FromHomogeneous returns a new vector by dividing the vector with its last
+// component and removing it. If the last component is 0 the vector
+// represents a point at infinity and ErrNotProjectable is returned.
+func (v Vector) FromHomogeneous() (Vector, error) {
+	dim := len(v) - 1
+
+	if dim < 0 || math.Abs(v[dim]) < 1e-8 {
+		return nil, ErrNotProjectable
+	}
+
+	return Scale(v[:dim], 1/v[dim]), nil
+}
+
+// LatLonToDirection converts a latitude and longitude in radians into a
+// 3-dimensional direction of length one. The axis follows the same
+// convention as Geodetic.ECEF, the z axis points towards the north pole and
+// the x axis points towards latitude and longitude 0. Use ConvertAxes for a
+// y-up convention.
+func LatLonToDirection(lat, lon float64) Vector {
+	sinLat, cosLat := math.Sincos(lat)
+	sinLon, cosLon := math.Sincos(lon)
+	return Vector{cosLat * cosLon, cosLat * sinLon, sinLat}
+}
+
+// DirectionToLatLon converts a 3-dimensional direction into a latitude and
+// longitude in radians, it is the inverse of LatLonToDirection. The
+// direction does not need to have a length of one.
+func DirectionToLatLon(v Vector) (lat, lon float64) {
+	x, y, z := v.X(), v.Y(), v.Z()
+	return math.Atan2(z, math.Hypot(x, y)), math.Atan2(y, x)
+}
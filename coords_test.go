@@ -0,0 +1,84 @@
+package vector_test
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/kvartborg/vector"
+)
+
+func ExampleFromPolar() {
+	fmt.Println(
+		vector.FromPolar(2, math.Pi/2),
+	)
+	// Output: [0 2]
+}
+
+func ExampleVector_ToPolar() {
+	fmt.Println(
+		vec{0, 2}.ToPolar(),
+	)
+	// Output: 2 1.5707963267948966
+}
+
+func ExampleFromSpherical() {
+	fmt.Println(
+		vector.FromSpherical(1, math.Pi/2, 0),
+	)
+	// Output: [1 0 0]
+}
+
+func ExampleVector_ToSpherical() {
+	fmt.Println(
+		vec{0, 0, 2}.ToSpherical(),
+	)
+	// Output: 2 0 0
+}
+
+func ExampleFromCylindrical() {
+	fmt.Println(
+		vector.FromCylindrical(2, 0, 3),
+	)
+	// Output: [2 0 3]
+}
+
+func ExampleVector_ToCylindrical() {
+	fmt.Println(
+		vec{0, 2, 3}.ToCylindrical(),
+	)
+	// Output: 2 1.5707963267948966 3
+}
+
+func ExampleVector_ToHomogeneous() {
+	fmt.Println(
+		vec{1, 2, 3}.ToHomogeneous(),
+	)
+	// Output: [1 2 3 1]
+}
+
+func ExampleVector_FromHomogeneous() {
+	fmt.Println(
+		vec{2, 4, 6, 2}.FromHomogeneous(),
+	)
+	fmt.Println(
+		vec{2, 4, 6, 0}.FromHomogeneous(),
+	)
+	// Output:
+	// [1 2 3] <nil>
+	// [] point can not be projected
+}
+
+func ExampleLatLonToDirection() {
+	fmt.Println(
+		vector.LatLonToDirection(0, 0),
+		vector.LatLonToDirection(math.Pi/2, 0),
+	)
+	// Output: [1 0 0] [0 0 1]
+}
+
+func ExampleDirectionToLatLon() {
+	fmt.Println(
+		vector.DirectionToLatLon(vec{0, 5, 0}),
+	)
+	// Output: 0 1.5707963267948966
+}
@@ -0,0 +1,303 @@
+package vector
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"unicode"
+)
+
+var (
+	// ErrInvalidExpression is returned when an expression passed to Eval can
+	// not be parsed or evaluated
+	ErrInvalidExpression = errors.New("invalid expression")
+)
+
+// Eval evaluates a vector expression with the given named vectors. An
+// expression supports addition, subtraction and multiplication by scalars,
+// parentheses, numbers, vector literals like [1, 2] and the functions dot,
+// cross and normalize.
+//
+//	vector.Eval("pos + normalize(target - pos) * 2", vars)
+//
+// If the expression evaluates to a scalar, it is returned as a 1-dimensional
+// vector. The vectors in vars are never modified.
+func Eval(expr string, vars map[string]Vector) (Vector, error) {
+	p := &exprParser{src: []rune(expr), vars: vars}
+	result, err := p.parseExpr()
+
+	if err != nil {
+		return nil, err
+	}
+
+	if p.skipSpace(); p.pos < len(p.src) {
+		return nil, p.errorf("unexpected %q", p.src[p.pos])
+	}
+
+	return result.v, nil
+}
+
+// exprValue is either a scalar or a vector, scalars are stored as a
+// 1-dimensional vector
+type exprValue struct {
+	v      Vector
+	scalar bool
+}
+
+type exprParser struct {
+	src  []rune
+	pos  int
+	vars map[string]Vector
+}
+
+func (p *exprParser) errorf(format string, args ...interface{}) error {
+	return fmt.Errorf("%w: %s at position %d", ErrInvalidExpression, fmt.Sprintf(format, args...), p.pos)
+}
+
+func (p *exprParser) skipSpace() {
+	for p.pos < len(p.src) && unicode.IsSpace(p.src[p.pos]) {
+		p.pos++
+	}
+}
+
+// consume skips whitespace and reports if the next rune is r, in that case
+// it is consumed
+func (p *exprParser) consume(r rune) bool {
+	if p.skipSpace(); p.pos < len(p.src) && p.src[p.pos] == r {
+		p.pos++
+		return true
+	}
+
+	return false
+}
+
+func (p *exprParser) parseExpr() (exprValue, error) {
+	left, err := p.parseTerm()
+
+	if err != nil {
+		return left, err
+	}
+
+	for {
+		var op rune
+
+		switch {
+		case p.consume('+'):
+			op = '+'
+		case p.consume('-'):
+			op = '-'
+		default:
+			return left, nil
+		}
+
+		right, err := p.parseTerm()
+
+		if err != nil {
+			return right, err
+		}
+
+		if left.scalar != right.scalar {
+			return left, p.errorf("can not mix scalars and vectors with %q", op)
+		}
+
+		if op == '+' {
+			left.v = Add(left.v, right.v)
+		} else {
+			left.v = Sub(left.v, right.v)
+		}
+	}
+}
+
+func (p *exprParser) parseTerm() (exprValue, error) {
+	left, err := p.parseUnary()
+
+	if err != nil {
+		return left, err
+	}
+
+	for p.consume('*') {
+		right, err := p.parseUnary()
+
+		if err != nil {
+			return right, err
+		}
+
+		switch {
+		case left.scalar:
+			left = exprValue{Scale(right.v, left.v[0]), right.scalar}
+		case right.scalar:
+			left.v = Scale(left.v, right.v[0])
+		default:
+			return left, p.errorf("can not multiply two vectors, use dot or cross")
+		}
+	}
+
+	return left, nil
+}
+
+func (p *exprParser) parseUnary() (exprValue, error) {
+	if p.consume('-') {
+		value, err := p.parseUnary()
+		value.v = Scale(value.v, -1)
+		return value, err
+	}
+
+	return p.parsePrimary()
+}
+
+func (p *exprParser) parsePrimary() (exprValue, error) {
+	p.skipSpace()
+
+	if p.pos >= len(p.src) {
+		return exprValue{}, p.errorf("unexpected end of expression")
+	}
+
+	switch r := p.src[p.pos]; {
+	case p.consume('('):
+		value, err := p.parseExpr()
+
+		if err == nil && !p.consume(')') {
+			err = p.errorf("missing )")
+		}
+
+		return value, err
+	case p.consume('['):
+		return p.parseLiteral()
+	case unicode.IsDigit(r) || r == '.':
+		n, err := p.parseNumber()
+		return exprValue{Vector{n}, true}, err
+	case unicode.IsLetter(r) || r == '_':
+		return p.parseIdent()
+	default:
+		return exprValue{}, p.errorf("unexpected %q", r)
+	}
+}
+
+func (p *exprParser) parseLiteral() (exprValue, error) {
+	v := Vector{}
+
+	if p.consume(']') {
+		return exprValue{v, false}, nil
+	}
+
+	for {
+		value, err := p.parseExpr()
+
+		if err != nil {
+			return value, err
+		}
+
+		if !value.scalar {
+			return value, p.errorf("vector literals can only contain scalars")
+		}
+
+		v = append(v, value.v[0])
+
+		if p.consume(']') {
+			return exprValue{v, false}, nil
+		}
+
+		if !p.consume(',') {
+			return value, p.errorf("missing ]")
+		}
+	}
+}
+
+func (p *exprParser) parseNumber() (float64, error) {
+	start := p.pos
+
+	for p.pos < len(p.src) && (unicode.IsDigit(p.src[p.pos]) || p.src[p.pos] == '.') {
+		p.pos++
+	}
+
+	if p.pos < len(p.src) && (p.src[p.pos] == 'e' || p.src[p.pos] == 'E') {
+		p.pos++
+
+		if p.pos < len(p.src) && (p.src[p.pos] == '+' || p.src[p.pos] == '-') {
+			p.pos++
+		}
+
+		for p.pos < len(p.src) && unicode.IsDigit(p.src[p.pos]) {
+			p.pos++
+		}
+	}
+
+	n, err := strconv.ParseFloat(string(p.src[start:p.pos]), 64)
+
+	if err != nil {
+		return 0, p.errorf("invalid number %q", string(p.src[start:p.pos]))
+	}
+
+	return n, nil
+}
+
+func (p *exprParser) parseIdent() (exprValue, error) {
+	start := p.pos
+
+	for p.pos < len(p.src) && (unicode.IsLetter(p.src[p.pos]) || unicode.IsDigit(p.src[p.pos]) || p.src[p.pos] == '_') {
+		p.pos++
+	}
+
+	name := string(p.src[start:p.pos])
+
+	if !p.consume('(') {
+		v, ok := p.vars[name]
+
+		if !ok {
+			return exprValue{}, p.errorf("unknown variable %q", name)
+		}
+
+		return exprValue{v.Clone(), false}, nil
+	}
+
+	args := []exprValue{}
+
+	for !p.consume(')') {
+		if len(args) > 0 && !p.consume(',') {
+			return exprValue{}, p.errorf("missing ) in call to %s", name)
+		}
+
+		arg, err := p.parseExpr()
+
+		if err != nil {
+			return arg, err
+		}
+
+		if arg.scalar {
+			return arg, p.errorf("%s expects vector arguments", name)
+		}
+
+		args = append(args, arg)
+	}
+
+	return p.call(name, args)
+}
+
+func (p *exprParser) call(name string, args []exprValue) (exprValue, error) {
+	arity := map[string]int{"dot": 2, "cross": 2, "normalize": 1}
+
+	n, ok := arity[name]
+
+	if !ok {
+		return exprValue{}, p.errorf("unknown function %q", name)
+	}
+
+	if len(args) != n {
+		return exprValue{}, p.errorf("%s expects %d arguments, got %d", name, n, len(args))
+	}
+
+	switch name {
+	case "dot":
+		return exprValue{Vector{Dot(args[0].v, args[1].v)}, true}, nil
+	case "cross":
+		v, err := Cross(args[0].v, args[1].v)
+
+		if err != nil {
+			return exprValue{}, p.errorf("%s", err)
+		}
+
+		return exprValue{v, false}, nil
+	default:
+		return exprValue{Unit(args[0].v), false}, nil
+	}
+}
@@ -0,0 +1,81 @@
+package vector_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/kvartborg/vector"
+)
+
+func TestEval(t *testing.T) {
+	vars := map[string]vec{
+		"a": {1, 2, 3},
+		"b": {3, 0, 4},
+	}
+
+	cases := map[string]vec{
+		"a + b":                   {4, 2, 7},
+		"a - b * 2":               {-5, 2, -5},
+		"-(a + b)":                {-4, -2, -7},
+		"2 * a":                   {2, 4, 6},
+		"dot(a, b)":               {15},
+		"normalize(b)":            {0.6, 0, 0.8},
+		"[1, 2.5e1, -1] + a":      {2, 27, 2},
+		"normalize(b) * dot(a,b)": {9, 0, 12},
+	}
+
+	for expr, expected := range cases {
+		result, err := vector.Eval(expr, vars)
+
+		if err != nil {
+			t.Errorf("%s: %v", expr, err)
+			continue
+		}
+
+		if !result.Equal(expected) {
+			t.Errorf("%s: expected %v, got %v", expr, expected, result)
+		}
+	}
+
+	if !vars["a"].Equal(vec{1, 2, 3}) || !vars["b"].Equal(vec{3, 0, 4}) {
+		t.Error("variables was modified by Eval")
+	}
+}
+
+func TestEvalDoesNotAliasVars(t *testing.T) {
+	vars := map[string]vec{"a": {1, 2, 3}}
+	result, err := vector.Eval("a", vars)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	result[0] = 10
+
+	if !vars["a"].Equal(vec{1, 2, 3}) {
+		t.Errorf("expected the result to not alias the variable, got %v", vars["a"])
+	}
+}
+
+func TestEvalInvalid(t *testing.T) {
+	vars := map[string]vec{"a": {1, 2}}
+
+	for _, expr := range []string{
+		"", "a +", "c", "a * a", "a + 1", "(a", "len(a)", "dot(a)", "cross(a, a)", "a )", "[a]",
+	} {
+		if _, err := vector.Eval(expr, vars); !errors.Is(err, vector.ErrInvalidExpression) {
+			t.Errorf("expected %q to be invalid, got %v", expr, err)
+		}
+	}
+}
+
+func ExampleEval() {
+	fmt.Println(
+		vector.Eval("pos + dir * 2", map[string]vec{
+			"pos": {1, 1},
+			"dir": {0, 1},
+		}),
+	)
+	// Output: [1 3] <nil>
+}
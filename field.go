@@ -0,0 +1,194 @@
+package vector
+
+import (
+	"errors"
+	"math"
+)
+
+var (
+	// ErrFieldSize is returned when a field is created without any axis or
+	// with less than one point along an axis
+	ErrFieldSize = errors.New("field must have at least one point along each axis")
+)
+
+// Field is a vector field with vectors stored at the points of a regular
+// grid, it can be 2 or 3-dimensional. Values are stored with the x axis
+// changing fastest, the field is sampled between the points with bilinear
+// or trilinear interpolation.
+type Field struct {
+	Origin  Vector
+	Spacing float64
+	Size    []int
+	Values  []Vector
+}
+
+// NewField creates a field of zero vectors with the given number of points
+// along each axis, the first point is at the origin and the points are
+// spacing apart. ErrFieldSize is returned if there is less than one point
+// along any axis.
+func NewField(origin Vector, spacing float64, size ...int) (*Field, error) {
+	if len(size) == 0 {
+		return nil, ErrFieldSize
+	}
+
+	n := 1
+
+	for _, s := range size {
+		if s < 1 {
+			return nil, ErrFieldSize
+		}
+
+		n *= s
+	}
+
+	values := make([]Vector, n)
+
+	for i := range values {
+		values[i] = make(Vector, len(size))
+	}
+
+	return &Field{Origin: origin, Spacing: spacing, Size: size, Values: values}, nil
+}
+
+// At returns the vector stored at a grid point
+func (f *Field) At(index ...int) Vector {
+	return f.Values[f.offset(index)]
+}
+
+// Set stores a vector at a grid point
+func (f *Field) Set(v Vector, index ...int) {
+	f.Values[f.offset(index)] = v
+}
+
+// offset returns the position of a grid point in the values
+func (f *Field) offset(index []int) int {
+	offset, stride := 0, 1
+
+	for i, s := range f.Size {
+		offset += index[i] * stride
+		stride *= s
+	}
+
+	return offset
+}
+
+// Sample returns the interpolated vector of the field at a position,
+// positions outside the grid are clamped to the edge of the grid. Stored
+// vectors with more dimensions than the field are truncated and missing
+// components are treated as zero.
+func (f *Field) Sample(p Vector) Vector {
+	dim := len(f.Size)
+	base := make([]int, dim)
+	t := make([]float64, dim)
+
+	for i, s := range f.Size {
+		u := (at(p, i) - at(f.Origin, i)) / f.Spacing
+		u = math.Max(0, math.Min(u, float64(s-1)))
+		base[i] = int(math.Min(math.Floor(u), math.Max(float64(s-2), 0)))
+		t[i] = u - float64(base[i])
+	}
+
+	result := make(Vector, dim)
+	index := make([]int, dim)
+
+	// sum the vectors at the corners of the cell, weighted by how close the
+	// position is to each corner
+	for corner := 0; corner < 1<<uint(dim); corner++ {
+		w := 1.
+
+		for i := range index {
+			index[i] = base[i]
+
+			if corner&(1<<uint(i)) == 0 {
+				w *= 1 - t[i]
+			} else if base[i]+1 < f.Size[i] {
+				index[i]++
+				w *= t[i]
+			} else {
+				w = 0
+			}
+		}
+
+		if w != 0 {
+			v := f.At(index...)
+			n := len(v)
+
+			if n > dim {
+				n = dim
+			}
+
+			axpyUnitaryTo(result[:n], w, v[:n], result[:n])
+		}
+	}
+
+	return result
+}
+
+// Gradient returns the gradient of a single component of the field at a
+// position, estimated with central differences
+func (f *Field) Gradient(p Vector, component Axis) Vector {
+	gradient := make(Vector, len(f.Size))
+
+	for i := range gradient {
+		gradient[i] = f.derivative(p, i, int(component))
+	}
+
+	return gradient
+}
+
+// Divergence returns the divergence of the field at a position, it is
+// positive where the field flows outward and negative where it flows inward
+func (f *Field) Divergence(p Vector) float64 {
+	divergence := 0.
+
+	for i := range f.Size {
+		divergence += f.derivative(p, i, i)
+	}
+
+	return divergence
+}
+
+// Curl returns the curl of the field at a position, it describes how the
+// field rotates around the position. The curl of a 2-dimensional field is
+// returned in the z component of a 3-dimensional vector.
+func (f *Field) Curl(p Vector) Vector {
+	d := func(axis, component int) float64 {
+		if axis >= len(f.Size) || component >= len(f.Size) {
+			return 0
+		}
+
+		return f.derivative(p, axis, component)
+	}
+
+	return Vector{
+		d(1, 2) - d(2, 1),
+		d(2, 0) - d(0, 2),
+		d(0, 1) - d(1, 0),
+	}
+}
+
+// derivative estimates the derivative of a component of the field along an
+// axis with central differences
+func (f *Field) derivative(p Vector, axis, component int) float64 {
+	h := f.Spacing / 2
+	a, b := f.offsetAlong(p, axis, -h), f.offsetAlong(p, axis, h)
+
+	return (at(f.Sample(b), component) - at(f.Sample(a), component)) / (2 * h)
+}
+
+// offsetAlong returns a copy of p moved by d along an axis
+func (f *Field) offsetAlong(p Vector, axis int, d float64) Vector {
+	q := make(Vector, len(f.Size))
+	copy(q, p)
+	q[axis] += d
+
+	return q
+}
+
+// Advect moves a particle at a position along the field for dt seconds,
+// treating the field as a velocity field. The midpoint method is used which
+// follows curved flow better than a plain Euler step.
+func (f *Field) Advect(p Vector, dt float64) Vector {
+	mid := AddScaled(p, f.Sample(p), dt/2)
+	return AddScaled(p, f.Sample(mid), dt)
+}
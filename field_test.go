@@ -0,0 +1,97 @@
+package vector_test
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/kvartborg/vector"
+)
+
+// newTestField creates a 2-dimensional field over [0, 4] x [0, 4] with the
+// given function sampled at each point
+func newTestField(fn func(x, y float64) vec) *vector.Field {
+	f, _ := vector.NewField(vec{0, 0}, 1, 5, 5)
+
+	for y := 0; y < 5; y++ {
+		for x := 0; x < 5; x++ {
+			f.Set(fn(float64(x), float64(y)), x, y)
+		}
+	}
+
+	return f
+}
+
+func TestFieldOperators(t *testing.T) {
+	expanding := newTestField(func(x, y float64) vec { return vec{x, y} })
+	rotating := newTestField(func(x, y float64) vec { return vec{-y, x} })
+	p := vec{2.3, 1.6}
+
+	if d := expanding.Divergence(p); math.Abs(d-2) > 1e-9 {
+		t.Errorf("expected a divergence of 2, got %v", d)
+	}
+
+	if d := rotating.Divergence(p); math.Abs(d) > 1e-9 {
+		t.Errorf("expected no divergence, got %v", d)
+	}
+
+	if c := rotating.Curl(p); !c.Equal(vec{0, 0, 2}) {
+		t.Errorf("expected a curl of [0 0 2], got %v", c)
+	}
+
+	if g := expanding.Gradient(p, vector.X); !g.Equal(vec{1, 0}) {
+		t.Errorf("expected a gradient of [1 0], got %v", g)
+	}
+}
+
+func TestNewFieldInvalidSize(t *testing.T) {
+	for _, size := range [][]int{{}, {0, 4}, {4, -1}} {
+		if f, err := vector.NewField(vec{0, 0}, 1, size...); err != vector.ErrFieldSize || f != nil {
+			t.Errorf("expected size %v to be rejected, got %v", size, err)
+		}
+	}
+}
+
+func TestFieldSample3D(t *testing.T) {
+	f, _ := vector.NewField(vec{0, 0, 0}, 2, 2, 2, 2)
+	f.Set(vec{8, 0, 0}, 1, 1, 1)
+
+	if v := f.Sample(vec{1, 1, 1}); !v.Equal(vec{1, 0, 0}) {
+		t.Errorf("expected [1 0 0] at the center of the cell, got %v", v)
+	}
+
+	if v := f.Sample(vec{5, 5, 5}); !v.Equal(vec{8, 0, 0}) {
+		t.Errorf("expected positions outside the grid to be clamped, got %v", v)
+	}
+}
+
+func TestFieldSampleMismatchedWidth(t *testing.T) {
+	f, _ := vector.NewField(vec{0, 0}, 1, 2, 2)
+	f.Set(vec{2, 4, 6}, 0, 0)
+	f.Set(vec{2}, 1, 0)
+
+	if v := f.Sample(vec{0.5, 0}); !v.Equal(vec{2, 2}) {
+		t.Errorf("expected wider vectors to be truncated and shorter padded with zero, got %v", v)
+	}
+}
+
+func ExampleField_Sample() {
+	f, _ := vector.NewField(vec{0, 0}, 10, 2, 2)
+	f.Set(vec{4, 0}, 1, 0)
+	f.Set(vec{0, 8}, 1, 1)
+
+	fmt.Println(
+		f.Sample(vec{5, 0}),
+		f.Sample(vec{10, 5}),
+	)
+	// Output: [2 0] [2 4]
+}
+
+func ExampleField_Advect() {
+	f := newTestField(func(x, y float64) vec { return vec{1, 0} })
+
+	fmt.Println(
+		f.Advect(vec{1, 1}, 0.5),
+	)
+	// Output: [1.5 1]
+}
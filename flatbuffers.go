@@ -0,0 +1,385 @@
+package vector
+
+import (
+	"encoding/binary"
+	"errors"
+	"math"
+)
+
+var (
+	// ErrInvalidFlatBuffer is returned when a buffer does not follow the
+	// layout described in vector.fbs
+	ErrInvalidFlatBuffer = errors.New("invalid flatbuffer")
+)
+
+// EncodeFlatBuffer encodes a set of vectors as a FlatBuffer following the
+// FlatVectors schema in vector.fbs. The result can be read without copying
+// by GetRootAsFlatVectors or by code generated by flatc.
+func EncodeFlatBuffer(vs ...Vector) []byte {
+	b := &flatBuilder{}
+	tables := make([]int, len(vs))
+
+	for i, v := range vs {
+		values := b.doubles(v)
+		tables[i] = b.table(flatRef(values))
+	}
+
+	list := b.offsets(tables...)
+	return b.finish(b.table(flatRef(list)))
+}
+
+// DecodeFlatBuffer validates and copies all vectors out of a FlatBuffer
+// following the FlatVectors schema in vector.fbs
+func DecodeFlatBuffer(buf []byte) ([]Vector, error) {
+	if len(buf) < 4 {
+		return nil, ErrInvalidFlatBuffer
+	}
+
+	root := flatTableAt(buf, int(binary.LittleEndian.Uint32(buf)))
+	list, n, ok := root.vector(0, 4)
+
+	if !ok {
+		return nil, ErrInvalidFlatBuffer
+	}
+
+	vs := make([]Vector, n)
+
+	for i := range vs {
+		slot := list + 4*i
+		table := flatTableAt(buf, slot+int(binary.LittleEndian.Uint32(buf[slot:])))
+		values, dim, ok := table.vector(0, 8)
+
+		if !ok {
+			return nil, ErrInvalidFlatBuffer
+		}
+
+		vs[i] = make(Vector, dim)
+
+		for j := range vs[i] {
+			vs[i][j] = math.Float64frombits(binary.LittleEndian.Uint64(buf[values+8*j:]))
+		}
+	}
+
+	return vs, nil
+}
+
+// FlatVectors provides zero-copy access to a list of vectors stored in a
+// FlatBuffer. The accessors does not validate the buffer, use
+// DecodeFlatBuffer for data that can not be trusted.
+type FlatVectors struct {
+	buf []byte
+	pos int
+}
+
+// GetRootAsFlatVectors returns the root FlatVectors table of a buffer
+func GetRootAsFlatVectors(buf []byte) FlatVectors {
+	return FlatVectors{buf, int(binary.LittleEndian.Uint32(buf))}
+}
+
+// Len returns the number of vectors in the list
+func (f FlatVectors) Len() int {
+	_, n, _ := flatTableAt(f.buf, f.pos).vector(0, 4)
+	return n
+}
+
+// At returns the vector at index i
+func (f FlatVectors) At(i int) FlatVector {
+	start, _, _ := flatTableAt(f.buf, f.pos).vector(0, 4)
+	slot := start + 4*i
+	return FlatVector{f.buf, slot + int(binary.LittleEndian.Uint32(f.buf[slot:]))}
+}
+
+// FlatVector provides zero-copy access to a single vector stored in a
+// FlatBuffer
+type FlatVector struct {
+	buf []byte
+	pos int
+}
+
+// Len returns the dimension of the vector
+func (f FlatVector) Len() int {
+	_, n, _ := flatTableAt(f.buf, f.pos).vector(0, 8)
+	return n
+}
+
+// At returns the scalar at index i
+func (f FlatVector) At(i int) float64 {
+	start, _, _ := flatTableAt(f.buf, f.pos).vector(0, 8)
+	return math.Float64frombits(binary.LittleEndian.Uint64(f.buf[start+8*i:]))
+}
+
+// Vector copies the scalars of the FlatVector into a new vector
+func (f FlatVector) Vector() Vector {
+	v := make(Vector, f.Len())
+
+	for i := range v {
+		v[i] = f.At(i)
+	}
+
+	return v
+}
+
+// flatBuilder builds a FlatBuffer from back to front like the official
+// FlatBuffers builders, so objects have to be created before the tables that
+// reference them. Positions of objects are measured from the end of the
+// buffer.
+type flatBuilder struct {
+	buf  []byte
+	head int
+}
+
+// flatField is a field of a table, a field with a size of 0 is not set
+type flatField struct {
+	size  int
+	value uint64
+	ref   bool
+}
+
+// flatRef returns a field that references the object at a position
+func flatRef(pos int) flatField {
+	return flatField{size: 4, value: uint64(pos), ref: true}
+}
+
+// offset returns the position of the last object written
+func (b *flatBuilder) offset() int {
+	return len(b.buf) - b.head
+}
+
+// prepend writes bytes in front of the buffer, growing it when needed
+func (b *flatBuilder) prepend(p ...byte) {
+	for b.head < len(p) {
+		grown := make([]byte, 2*len(b.buf)+len(p))
+		copy(grown[len(grown)-b.offset():], b.buf[b.head:])
+		b.head += len(grown) - len(b.buf)
+		b.buf = grown
+	}
+
+	b.head -= len(p)
+	copy(b.buf[b.head:], p)
+}
+
+// prependUint writes an unsigned little endian integer of size bytes
+func (b *flatBuilder) prependUint(size int, v uint64) {
+	var p [8]byte
+	binary.LittleEndian.PutUint64(p[:], v)
+	b.prepend(p[:size]...)
+}
+
+// prep pads the buffer so it is aligned to size after additional bytes are
+// written
+func (b *flatBuilder) prep(size, additional int) {
+	for (b.offset()+additional)%size != 0 {
+		b.prepend(0)
+	}
+}
+
+// table writes a table followed by its vtable and returns its position
+func (b *flatBuilder) table(fields ...flatField) int {
+	end := b.offset()
+	positions := make([]int, len(fields))
+
+	for i := len(fields) - 1; i >= 0; i-- {
+		f := fields[i]
+
+		if f.size == 0 {
+			continue
+		}
+
+		b.prep(f.size, 0)
+
+		if f.ref {
+			b.prependUint(4, uint64(b.offset()+4-int(f.value)))
+		} else {
+			b.prependUint(f.size, f.value)
+		}
+
+		positions[i] = b.offset()
+	}
+
+	b.prep(4, 0)
+	b.prependUint(4, 0)
+	table := b.offset()
+
+	for i := len(fields) - 1; i >= 0; i-- {
+		if positions[i] == 0 {
+			b.prependUint(2, 0)
+		} else {
+			b.prependUint(2, uint64(table-positions[i]))
+		}
+	}
+
+	b.prependUint(2, uint64(table-end))
+	b.prependUint(2, uint64(4+2*len(fields)))
+
+	// the vtable is in front of the table, so the signed offset is positive
+	binary.LittleEndian.PutUint32(b.buf[len(b.buf)-table:], uint32(b.offset()-table))
+	return table
+}
+
+// offsets writes a vector of references to objects
+func (b *flatBuilder) offsets(positions ...int) int {
+	b.prep(4, 4*len(positions))
+
+	for i := len(positions) - 1; i >= 0; i-- {
+		b.prependUint(4, uint64(b.offset()+4-positions[i]))
+	}
+
+	b.prependUint(4, uint64(len(positions)))
+	return b.offset()
+}
+
+// pairs writes a vector of structs with two longs, like the FieldNode and
+// Buffer structs of Arrow
+func (b *flatBuilder) pairs(values [][2]int64) int {
+	b.prep(8, 16*len(values))
+
+	for i := len(values) - 1; i >= 0; i-- {
+		b.prependUint(8, uint64(values[i][1]))
+		b.prependUint(8, uint64(values[i][0]))
+	}
+
+	b.prependUint(4, uint64(len(values)))
+	return b.offset()
+}
+
+// doubles writes a vector of doubles
+func (b *flatBuilder) doubles(v []float64) int {
+	b.prep(8, 8*len(v))
+
+	for i := len(v) - 1; i >= 0; i-- {
+		b.prependUint(8, math.Float64bits(v[i]))
+	}
+
+	b.prependUint(4, uint64(len(v)))
+	return b.offset()
+}
+
+// string writes a null terminated string
+func (b *flatBuilder) string(s string) int {
+	b.prep(4, len(s)+1)
+	b.prepend(append([]byte(s), 0)...)
+	b.prependUint(4, uint64(len(s)))
+	return b.offset()
+}
+
+// finish writes the reference to the root table and returns the buffer,
+// which is padded to a multiple of 8 bytes
+func (b *flatBuilder) finish(root int) []byte {
+	b.prep(8, 4)
+	b.prependUint(4, uint64(b.offset()+4-root))
+	return b.buf[b.head:]
+}
+
+// flatTable provides bounds checked access to the fields of a table in a
+// FlatBuffer
+type flatTable struct {
+	buf         []byte
+	pos, vtable int
+	ok          bool
+}
+
+// flatTableAt returns the table at a position, the table is not ok if its
+// vtable is out of bounds
+func flatTableAt(buf []byte, pos int) flatTable {
+	t := flatTable{buf: buf, pos: pos}
+
+	if pos < 0 || pos+4 > len(buf) {
+		return t
+	}
+
+	t.vtable = pos - int(int32(binary.LittleEndian.Uint32(buf[pos:])))
+
+	if t.vtable < 0 || t.vtable+4 > len(buf) {
+		return t
+	}
+
+	t.ok = t.vtable+int(binary.LittleEndian.Uint16(buf[t.vtable:])) <= len(buf)
+	return t
+}
+
+// field returns the position of a field, or 0 if it is not set
+func (t flatTable) field(id int) int {
+	entry := 4 + 2*id
+
+	if !t.ok || entry+2 > int(binary.LittleEndian.Uint16(t.buf[t.vtable:])) {
+		return 0
+	}
+
+	offset := int(binary.LittleEndian.Uint16(t.buf[t.vtable+entry:]))
+
+	if offset == 0 {
+		return 0
+	}
+
+	return t.pos + offset
+}
+
+// scalar reads an unsigned little endian field of size bytes, the default
+// is returned if the field is not set
+func (t flatTable) scalar(id, size int, def uint64) (uint64, bool) {
+	field := t.field(id)
+
+	if field == 0 {
+		return def, t.ok
+	}
+
+	if field+size > len(t.buf) {
+		return 0, false
+	}
+
+	v := uint64(0)
+
+	for i := size - 1; i >= 0; i-- {
+		v = v<<8 | uint64(t.buf[field+i])
+	}
+
+	return v, true
+}
+
+// ref returns the position of the object referenced by a field, or 0 if the
+// field is not set
+func (t flatTable) ref(id int) (int, bool) {
+	field := t.field(id)
+
+	if field == 0 {
+		return 0, t.ok
+	}
+
+	if field+4 > len(t.buf) {
+		return 0, false
+	}
+
+	pos := field + int(binary.LittleEndian.Uint32(t.buf[field:]))
+	return pos, pos > field && pos < len(t.buf)
+}
+
+// vector returns the position of the first element and the length of a
+// vector field, where each element is size bytes
+func (t flatTable) vector(id, size int) (start, n int, ok bool) {
+	pos, ok := t.ref(id)
+
+	if !ok || pos == 0 {
+		return 0, 0, ok
+	}
+
+	if pos+4 > len(t.buf) {
+		return 0, 0, false
+	}
+
+	start = pos + 4
+	n = int(binary.LittleEndian.Uint32(t.buf[pos:]))
+
+	if n < 0 || n > (len(t.buf)-start)/size {
+		return 0, 0, false
+	}
+
+	return start, n, true
+}
+
+func appendUint32(buf []byte, v uint32) []byte {
+	return append(buf, byte(v), byte(v>>8), byte(v>>16), byte(v>>24))
+}
+
+func appendUint64(buf []byte, v uint64) []byte {
+	return appendUint32(appendUint32(buf, uint32(v)), uint32(v>>32))
+}
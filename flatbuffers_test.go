@@ -0,0 +1,49 @@
+package vector_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/kvartborg/vector"
+)
+
+func TestFlatBufferRoundTrip(t *testing.T) {
+	vs := []vec{{1, 2, 3}, {}, {4.5}}
+	buf := vector.EncodeFlatBuffer(vs...)
+
+	result, err := vector.DecodeFlatBuffer(buf)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(result) != len(vs) {
+		t.Fatalf("expected %d vectors, got %d", len(vs), len(result))
+	}
+
+	for i := range vs {
+		if !result[i].Equal(vs[i]) {
+			t.Errorf("expected %v, got %v", vs[i], result[i])
+		}
+	}
+}
+
+func TestFlatBufferInvalid(t *testing.T) {
+	buf := vector.EncodeFlatBuffer(vec{1, 2, 3})
+
+	if _, err := vector.DecodeFlatBuffer(buf[:len(buf)-8]); err != vector.ErrInvalidFlatBuffer {
+		t.Error("did not detect truncated buffer")
+	}
+
+	if _, err := vector.DecodeFlatBuffer(nil); err != vector.ErrInvalidFlatBuffer {
+		t.Error("did not detect empty buffer")
+	}
+}
+
+func ExampleGetRootAsFlatVectors() {
+	buf := vector.EncodeFlatBuffer(vec{1, 2}, vec{3, 4, 5})
+	vs := vector.GetRootAsFlatVectors(buf)
+
+	fmt.Println(vs.Len(), vs.At(1).At(2), vs.At(0).Vector())
+	// Output: 2 5 [1 2]
+}
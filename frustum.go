@@ -0,0 +1,81 @@
+package vector
+
+// Frustum is the volume a camera can see, described by six planes with
+// normals that point into the volume
+type Frustum struct {
+	Planes [6]Plane
+}
+
+// NewFrustum extracts the planes of the frustum from a combined view and
+// projection matrix that maps into normalized device coordinates in the
+// range [-1, 1], like Perspective and Orthographic
+func NewFrustum(viewProj Matrix4) Frustum {
+	m := viewProj
+	row := func(i int) Vector {
+		return Vector{m[i*4], m[i*4+1], m[i*4+2], m[i*4+3]}
+	}
+
+	w := row(3)
+	sources := [6]Vector{
+		Add(w, row(0)), Sub(w, row(0)),
+		Add(w, row(1)), Sub(w, row(1)),
+		Add(w, row(2)), Sub(w, row(2)),
+	}
+
+	var f Frustum
+
+	for i, p := range sources {
+		n := Vector{p[X], p[Y], p[Z]}
+		l := n.Magnitude()
+		f.Planes[i] = Plane{n.Scale(1 / l), p[W] / l}
+	}
+
+	return f
+}
+
+// ContainsPoint reports if a point is inside the frustum
+func (f Frustum) ContainsPoint(v Vector) bool {
+	for _, p := range f.Planes {
+		if p.DistanceToPoint(v) < 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
+// IntersectsSphere reports if a sphere is inside or partly inside the
+// frustum, a sphere just outside a corner of the frustum can be reported as
+// intersecting
+func (f Frustum) IntersectsSphere(s Sphere) bool {
+	for _, p := range f.Planes {
+		if p.DistanceToPoint(s.Center) < -s.Radius {
+			return false
+		}
+	}
+
+	return true
+}
+
+// IntersectsAABB reports if a box is inside or partly inside the frustum, a
+// box just outside a corner of the frustum can be reported as intersecting
+func (f Frustum) IntersectsAABB(box AABB) bool {
+	for _, p := range f.Planes {
+		// the corner of the box furthest along the normal of the plane
+		corner := make(Vector, 3)
+
+		for i := range corner {
+			if at(p.Normal, i) >= 0 {
+				corner[i] = at(box.Max, i)
+			} else {
+				corner[i] = at(box.Min, i)
+			}
+		}
+
+		if p.DistanceToPoint(corner) < 0 {
+			return false
+		}
+	}
+
+	return true
+}
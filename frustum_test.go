@@ -0,0 +1,62 @@
+package vector_test
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/kvartborg/vector"
+)
+
+// testFrustum returns the frustum of a camera at the origin looking down the
+// negative z axis with a 90 degree field of view
+func testFrustum() vector.Frustum {
+	return vector.NewFrustum(vector.Perspective(math.Pi/2, 1, 1, 100))
+}
+
+func TestFrustumContainsPoint(t *testing.T) {
+	f := testFrustum()
+
+	for _, v := range []vec{{0, 0, -10}, {4, 4, -5}, {0, 0, -99}} {
+		if !f.ContainsPoint(v) {
+			t.Errorf("expected %v to be inside the frustum", v)
+		}
+	}
+
+	for _, v := range []vec{{0, 0, 10}, {6, 0, -5}, {0, 0, -0.5}, {0, 0, -101}} {
+		if f.ContainsPoint(v) {
+			t.Errorf("expected %v to be outside the frustum", v)
+		}
+	}
+}
+
+func TestFrustumIntersects(t *testing.T) {
+	f := testFrustum()
+
+	if !f.IntersectsSphere(vector.Sphere{Center: vec{7, 0, -5}, Radius: 2}) {
+		t.Error("expected the sphere to reach into the frustum")
+	}
+
+	if f.IntersectsSphere(vector.Sphere{Center: vec{0, 0, 5}, Radius: 2}) {
+		t.Error("expected the sphere behind the camera to be culled")
+	}
+
+	if !f.IntersectsAABB(vector.AABB{Min: vec{5, -1, -6}, Max: vec{8, 1, -4}}) {
+		t.Error("expected the box to reach into the frustum")
+	}
+
+	if f.IntersectsAABB(vector.AABB{Min: vec{-1, -1, 1}, Max: vec{1, 1, 3}}) {
+		t.Error("expected the box behind the camera to be culled")
+	}
+}
+
+func ExampleFrustum_ContainsPoint() {
+	view, _ := vector.LookAt(vec{0, 0, 10}, vec{0, 0, 0}, vec{0, 1, 0})
+	f := vector.NewFrustum(vector.Perspective(math.Pi/2, 1, 1, 100).Mul(view))
+
+	fmt.Println(
+		f.ContainsPoint(vec{0, 0, 0}),
+		f.ContainsPoint(vec{0, 0, 20}),
+	)
+	// Output: true false
+}
@@ -0,0 +1,78 @@
+package vector
+
+import "math"
+
+// parameters of the WGS84 ellipsoid
+const (
+	wgs84A  = 6378137.0
+	wgs84F  = 1 / 298.257223563
+	wgs84B  = wgs84A * (1 - wgs84F)
+	wgs84E2 = wgs84F * (2 - wgs84F)
+)
+
+// Geodetic is a position on the WGS84 ellipsoid, latitude and longitude are
+// in radians and altitude is in meters above the ellipsoid.
+type Geodetic struct {
+	Lat, Lon, Alt float64
+}
+
+// ECEF converts a geodetic position into a 3-dimensional earth-centered,
+// earth-fixed vector in meters
+func (g Geodetic) ECEF() Vector {
+	sinLat, cosLat := math.Sincos(g.Lat)
+	sinLon, cosLon := math.Sincos(g.Lon)
+	n := wgs84A / math.Sqrt(1-wgs84E2*sinLat*sinLat)
+
+	return Vector{
+		(n + g.Alt) * cosLat * cosLon,
+		(n + g.Alt) * cosLat * sinLon,
+		(n*(1-wgs84E2) + g.Alt) * sinLat,
+	}
+}
+
+// FromECEF converts an earth-centered, earth-fixed vector in meters into a
+// geodetic position using Bowring's method
+func FromECEF(v Vector) Geodetic {
+	x, y, z := v.X(), v.Y(), v.Z()
+	p := math.Hypot(x, y)
+	ep2 := (wgs84A*wgs84A - wgs84B*wgs84B) / (wgs84B * wgs84B)
+
+	sinT, cosT := math.Sincos(math.Atan2(z*wgs84A, p*wgs84B))
+	lat := math.Atan2(
+		z+ep2*wgs84B*sinT*sinT*sinT,
+		p-wgs84E2*wgs84A*cosT*cosT*cosT,
+	)
+
+	sinLat, cosLat := math.Sincos(lat)
+	alt := p*cosLat + z*sinLat - wgs84A*math.Sqrt(1-wgs84E2*sinLat*sinLat)
+
+	return Geodetic{lat, math.Atan2(y, x), alt}
+}
+
+// ToENU converts a geodetic position into a local east-north-up vector in
+// meters relative to the origin
+func ToENU(g, origin Geodetic) Vector {
+	d := g.ECEF().Sub(origin.ECEF())
+	sinLat, cosLat := math.Sincos(origin.Lat)
+	sinLon, cosLon := math.Sincos(origin.Lon)
+
+	return Vector{
+		-sinLon*d[X] + cosLon*d[Y],
+		-sinLat*cosLon*d[X] - sinLat*sinLon*d[Y] + cosLat*d[Z],
+		cosLat*cosLon*d[X] + cosLat*sinLon*d[Y] + sinLat*d[Z],
+	}
+}
+
+// FromENU converts a local east-north-up vector in meters relative to the
+// origin back into a geodetic position
+func FromENU(v Vector, origin Geodetic) Geodetic {
+	e, n, u := v.X(), v.Y(), v.Z()
+	sinLat, cosLat := math.Sincos(origin.Lat)
+	sinLon, cosLon := math.Sincos(origin.Lon)
+
+	return FromECEF(origin.ECEF().Add(Vector{
+		-sinLon*e - sinLat*cosLon*n + cosLat*cosLon*u,
+		cosLon*e - sinLat*sinLon*n + cosLat*sinLon*u,
+		cosLat*n + sinLat*u,
+	}))
+}
@@ -0,0 +1,45 @@
+package vector_test
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/kvartborg/vector"
+)
+
+func TestGeodeticECEFRoundTrip(t *testing.T) {
+	for _, g := range []vector.Geodetic{
+		{0, 0, 0},
+		{0.9723, 0.2181, 120},
+		{-0.5, -2.3, 10000},
+		{math.Pi / 2, 0, 5},
+	} {
+		result := vector.FromECEF(g.ECEF())
+
+		if math.Abs(result.Lat-g.Lat) > 1e-9 || math.Abs(result.Alt-g.Alt) > 1e-3 {
+			t.Errorf("expected %v, got %v", g, result)
+		}
+	}
+}
+
+func TestENURoundTrip(t *testing.T) {
+	origin := vector.Geodetic{Lat: 0.9723, Lon: 0.2181, Alt: 50}
+	enu := vec{100, -250, 30}
+
+	result := vector.ToENU(vector.FromENU(enu, origin), origin)
+
+	if vector.Sub(result, enu).Magnitude() > 1e-3 {
+		t.Errorf("expected %v, got %v", enu, result)
+	}
+}
+
+func ExampleToENU() {
+	origin := vector.Geodetic{Lat: 0, Lon: 0, Alt: 0}
+	up := vector.Geodetic{Lat: 0, Lon: 0, Alt: 10}
+
+	fmt.Println(
+		vector.ToENU(up, origin),
+	)
+	// Output: [0 0 10]
+}
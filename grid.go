@@ -0,0 +1,111 @@
+package vector
+
+import "math"
+
+// WorldToIso converts a 2-dimensional position in tile units into isometric
+// screen coordinates, where a tile is drawn as a diamond of the given width
+// and height in pixels
+func WorldToIso(v Vector, tileWidth, tileHeight float64) Vector {
+	x, y := v.X(), v.Y()
+	return Vector{(x - y) * tileWidth / 2, (x + y) * tileHeight / 2}
+}
+
+// IsoToWorld converts isometric screen coordinates into a 2-dimensional
+// position in tile units, it is the inverse of WorldToIso
+func IsoToWorld(screen Vector, tileWidth, tileHeight float64) Vector {
+	x, y := screen.X()/tileWidth, screen.Y()/tileHeight
+	return Vector{y + x, y - x}
+}
+
+// HexOrientation is an enum type that describes how hexagons are laid out
+// in a hex grid
+type HexOrientation int
+
+const (
+	// PointyTop hexagons have a corner at the top and are laid out in rows
+	PointyTop HexOrientation = iota
+	// FlatTop hexagons have an edge at the top and are laid out in columns
+	FlatTop
+)
+
+// WorldToHex converts a 2-dimensional position into fractional axial hex
+// coordinates (q, r), size is the distance from the center of a hexagon to
+// its corners. Use HexRound to find the hexagon that contains the position.
+func WorldToHex(v Vector, size float64, o HexOrientation) Vector {
+	x, y := v.X()/size, v.Y()/size
+
+	if o == FlatTop {
+		return Vector{2. / 3 * x, -1./3*x + math.Sqrt(3)/3*y}
+	}
+
+	return Vector{math.Sqrt(3)/3*x - 1./3*y, 2. / 3 * y}
+}
+
+// HexToWorld converts axial hex coordinates (q, r) into the 2-dimensional
+// position of the center of the hexagon, it is the inverse of WorldToHex
+func HexToWorld(axial Vector, size float64, o HexOrientation) Vector {
+	q, r := axial.X(), axial.Y()
+
+	if o == FlatTop {
+		return Vector{size * 3 / 2 * q, size * (math.Sqrt(3)/2*q + math.Sqrt(3)*r)}
+	}
+
+	return Vector{size * (math.Sqrt(3)*q + math.Sqrt(3)/2*r), size * 3 / 2 * r}
+}
+
+// HexRound rounds fractional axial hex coordinates (q, r) to the axial
+// coordinates of the hexagon that contains them
+func HexRound(axial Vector) Vector {
+	q, r := axial.X(), axial.Y()
+	s := -q - r
+	rq, rr, rs := math.Round(q), math.Round(r), math.Round(s)
+	dq, dr, ds := math.Abs(rq-q), math.Abs(rr-r), math.Abs(rs-s)
+
+	if dq > dr && dq > ds {
+		rq = 0 - rr - rs
+	} else if dr > ds {
+		rr = 0 - rq - rs
+	}
+
+	return Vector{rq, rr}
+}
+
+// AxialToCube converts axial hex coordinates (q, r) into cube coordinates
+// (q, r, s) where q + r + s = 0
+func AxialToCube(axial Vector) Vector {
+	q, r := axial.X(), axial.Y()
+	return Vector{q, r, -q - r}
+}
+
+// CubeToAxial converts cube hex coordinates (q, r, s) into axial coordinates
+// (q, r)
+func CubeToAxial(cube Vector) Vector {
+	return Vector{cube.X(), cube.Y()}
+}
+
+// QuantizeDirection snaps the direction of a 2-dimensional vector to the
+// nearest of a number of evenly spaced compass directions, starting at the x
+// axis. The result has a length of one, 4 directions gives grid movement
+// without diagonals and 8 directions includes diagonals. A zero vector is
+// returned unchanged.
+func QuantizeDirection(v Vector, directions int) Vector {
+	x, y := v.X(), v.Y()
+
+	if directions <= 0 || (x == 0 && y == 0) {
+		return Vector{x, y}
+	}
+
+	step := 2 * math.Pi / float64(directions)
+	sin, cos := math.Sincos(math.Round(math.Atan2(y, x)/step) * step)
+
+	// remove the rounding errors of sin and cos so axis are exactly 0
+	if math.Abs(sin) < 1e-12 {
+		sin = 0
+	}
+
+	if math.Abs(cos) < 1e-12 {
+		cos = 0
+	}
+
+	return Vector{cos, sin}
+}
@@ -0,0 +1,72 @@
+package vector_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/kvartborg/vector"
+)
+
+func TestIsoRoundTrip(t *testing.T) {
+	v := vec{3, -2}
+
+	if result := vector.IsoToWorld(vector.WorldToIso(v, 64, 32), 64, 32); !result.Equal(v) {
+		t.Errorf("expected %v, got %v", v, result)
+	}
+}
+
+func TestHexRoundTrip(t *testing.T) {
+	for _, o := range []vector.HexOrientation{vector.PointyTop, vector.FlatTop} {
+		axial := vec{2, -3}
+		center := vector.HexToWorld(axial, 10, o)
+
+		if result := vector.WorldToHex(center, 10, o); !result.Equal(axial) {
+			t.Errorf("expected %v, got %v", axial, result)
+		}
+
+		// a point near the center of a hexagon rounds to that hexagon
+		if result := vector.HexRound(vector.WorldToHex(vector.Add(center, vec{3, -4}), 10, o)); !result.Equal(axial) {
+			t.Errorf("expected %v, got %v", axial, result)
+		}
+	}
+}
+
+func ExampleWorldToIso() {
+	fmt.Println(
+		vector.WorldToIso(vec{1, 0}, 64, 32),
+		vector.WorldToIso(vec{1, 1}, 64, 32),
+	)
+	// Output: [32 16] [0 32]
+}
+
+func ExampleIsoToWorld() {
+	fmt.Println(
+		vector.IsoToWorld(vec{32, 16}, 64, 32),
+	)
+	// Output: [1 0]
+}
+
+func ExampleHexRound() {
+	fmt.Println(
+		vector.HexRound(vec{0.6, 0.3}),
+		vector.HexRound(vec{1.2, -0.4}),
+	)
+	// Output: [1 0] [1 0]
+}
+
+func ExampleAxialToCube() {
+	fmt.Println(
+		vector.AxialToCube(vec{1, 2}),
+		vector.CubeToAxial(vec{1, 2, -3}),
+	)
+	// Output: [1 2 -3] [1 2]
+}
+
+func ExampleQuantizeDirection() {
+	fmt.Println(
+		vector.QuantizeDirection(vec{0.2, 3}, 4),
+		vector.QuantizeDirection(vec{-1, -0.1}, 4),
+		vector.QuantizeDirection(vec{2, 1.8}, 8),
+	)
+	// Output: [0 1] [-1 0] [0.7071067811865476 0.7071067811865475]
+}
@@ -0,0 +1,85 @@
+package vector
+
+// Handedness is an enum type that describes the orientation of a
+// 3-dimensional coordinate system. The package level functions like Cross,
+// Rotate and LookAt follow the right-handed convention, the methods on
+// Handedness provide the same operations for a specific convention.
+type Handedness int
+
+const (
+	// RightHanded follows the right-hand rule, cameras look down their
+	// negative z axis
+	RightHanded Handedness = iota
+	// LeftHanded follows the left-hand rule, cameras look down their
+	// positive z axis
+	LeftHanded
+)
+
+// Cross product of two vectors following the given handedness. The
+// left-handed cross product is the negated right-handed one, so v1, v2 and
+// the result follow the left-hand rule instead of the right-hand rule.
+func (h Handedness) Cross(v1, v2 Vector) (Vector, error) {
+	if h == LeftHanded {
+		return Cross(v2, v1)
+	}
+
+	return Cross(v1, v2)
+}
+
+// Rotate a vector around a specified axis following the given handedness.
+// Looking down the axis towards the origin, a positive angle rotates
+// counterclockwise in the right-handed convention and clockwise in the
+// left-handed convention, which is the same as Rotate with a negated angle.
+// See Rotate for how the axis and dimensions are handled.
+func (h Handedness) Rotate(v Vector, angle float64, as ...Axis) Vector {
+	if h == LeftHanded {
+		angle = -angle
+	}
+
+	return Rotate(v, angle, as...)
+}
+
+// LookAt creates a view matrix for a camera at eye looking at target, up is
+// used to orient the camera around its viewing direction. A right-handed
+// camera looks down its negative z axis and a left-handed camera looks down
+// its positive z axis.
+func (h Handedness) LookAt(eye, target, up Vector) (Matrix4, error) {
+	if len(eye) != 3 || len(target) != 3 || len(up) != 3 {
+		return Matrix4{}, ErrNot3Dimensional
+	}
+
+	forward := Sub(target, eye)
+
+	if forward.Magnitude() < 1e-8 {
+		return Matrix4{}, ErrZeroVector
+	}
+
+	forward.Unit()
+	side, _ := Cross(forward, up)
+
+	if side.Magnitude() < 1e-8 {
+		return Matrix4{}, ErrZeroVector
+	}
+
+	side.Unit()
+	camUp, _ := Cross(side, forward)
+
+	if h == LeftHanded {
+		side.Scale(-1)
+	} else {
+		forward.Scale(-1)
+	}
+
+	return Matrix4{
+		side[X], side[Y], side[Z], -Dot(side, eye),
+		camUp[X], camUp[Y], camUp[Z], -Dot(camUp, eye),
+		forward[X], forward[Y], forward[Z], -Dot(forward, eye),
+		0, 0, 0, 1,
+	}, nil
+}
+
+// LookAt creates a right-handed view matrix for a camera at eye looking at
+// target, see Handedness.LookAt
+func LookAt(eye, target, up Vector) (Matrix4, error) {
+	return RightHanded.LookAt(eye, target, up)
+}
@@ -0,0 +1,66 @@
+package vector_test
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/kvartborg/vector"
+)
+
+func TestLookAt(t *testing.T) {
+	eye, target, up := vec{1, 2, 5}, vec{1, 2, 0}, vec{0, 1, 0}
+
+	rh, err := vector.RightHanded.LookAt(eye, target, up)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if result := rh.MulVec(vec{1, 2, 0, 1}); !result.Equal(vec{0, 0, -5, 1}) {
+		t.Errorf("expected right-handed camera to look down -z, got %v", result)
+	}
+
+	lh, err := vector.LeftHanded.LookAt(eye, target, up)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if result := lh.MulVec(vec{1, 2, 0, 1}); !result.Equal(vec{0, 0, 5, 1}) {
+		t.Errorf("expected left-handed camera to look down +z, got %v", result)
+	}
+
+	// looking down the world -z axis, the right of a left-handed camera is -x
+	if result := lh.MulVec(vec{2, 3, 5, 1}); !result.Equal(vec{-1, 1, 0, 1}) {
+		t.Errorf("expected left-handed camera to have -x to the right, got %v", result)
+	}
+
+	if _, err := vector.LookAt(eye, eye, up); err != vector.ErrZeroVector {
+		t.Error("expected eye and target at the same position to be rejected")
+	}
+
+	if _, err := vector.LookAt(eye, target, vec{0, 0, 1}); err != vector.ErrZeroVector {
+		t.Error("expected up parallel to the view direction to be rejected")
+	}
+}
+
+func ExampleHandedness_Cross() {
+	fmt.Println(
+		vector.RightHanded.Cross(vec{1, 0, 0}, vec{0, 1, 0}),
+	)
+	fmt.Println(
+		vector.LeftHanded.Cross(vec{1, 0, 0}, vec{0, 1, 0}),
+	)
+	// Output:
+	// [0 0 1] <nil>
+	// [0 0 -1] <nil>
+}
+
+func ExampleHandedness_Rotate() {
+	fmt.Println(
+		vector.RightHanded.Rotate(vec{1, 0}, math.Pi/2),
+		vector.LeftHanded.Rotate(vec{1, 0}, math.Pi/2),
+	)
+	// Output: [0 1] [0 -1]
+}
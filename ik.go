@@ -0,0 +1,135 @@
+package vector
+
+import "math"
+
+// IKChain is a chain of joints connected by bones of fixed length, that can
+// be solved with inverse kinematics to reach a target. Bone i connects joint
+// i and joint i+1, the first joint is the fixed root of the chain.
+type IKChain struct {
+	Joints  []Vector
+	Lengths []float64
+
+	// MaxAngles optionally limits how far each bone can bend away from the
+	// direction of the previous bone, in radians. The limits are applied in
+	// both passes of Solve. The first bone is not constrained and a missing
+	// or negative value means no limit.
+	MaxAngles []float64
+}
+
+// NewIKChain creates a chain from copies of the joint positions, the bone
+// lengths are the distances between the joints
+func NewIKChain(joints ...Vector) *IKChain {
+	c := &IKChain{
+		Joints:  make([]Vector, len(joints)),
+		Lengths: make([]float64, 0, len(joints)),
+	}
+
+	for i := range joints {
+		c.Joints[i] = joints[i].Clone()
+
+		if i > 0 {
+			c.Lengths = append(c.Lengths, Sub(joints[i], joints[i-1]).Magnitude())
+		}
+	}
+
+	return c
+}
+
+// Solve moves the joints so the end of the chain reaches the target using
+// the FABRIK algorithm, iterating until the end is within the tolerance of
+// the target or the max number of iterations is reached. It reports if the
+// target was reached, if the target is out of reach the chain is stretched
+// towards it.
+func (c *IKChain) Solve(target Vector, tolerance float64, iterations int) bool {
+	n := len(c.Joints)
+
+	if n < 2 || len(c.Lengths) < n-1 {
+		return false
+	}
+
+	root := c.Joints[0].Clone()
+	total := 0.
+
+	for i := 0; i < n-1; i++ {
+		total += c.Lengths[i]
+	}
+
+	if Sub(target, root).Magnitude() > total {
+		for i := 0; i < n-1; i++ {
+			c.place(i, Sub(target, c.Joints[i]))
+		}
+
+		return false
+	}
+
+	for k := 0; k < iterations; k++ {
+		if Sub(c.Joints[n-1], target).Magnitude() <= tolerance {
+			return true
+		}
+
+		// backward pass, from the end towards the root
+		c.Joints[n-1] = target.Clone()
+
+		for i := n - 2; i >= 0; i-- {
+			dir := Sub(c.Joints[i+1], c.Joints[i]).Unit()
+
+			// bone i is limited by the angle to bone i+1 which is already placed
+			if max, ok := c.maxAngle(i + 1); ok && i+2 < n {
+				next := Sub(c.Joints[i+2], c.Joints[i+1]).Unit()
+				dir = constrainAngle(dir, next, max)
+			}
+
+			c.Joints[i] = Sub(c.Joints[i+1], dir.Scale(c.Lengths[i]))
+		}
+
+		// forward pass, from the root towards the end
+		c.Joints[0] = root.Clone()
+
+		for i := 0; i < n-1; i++ {
+			c.place(i, Sub(c.Joints[i+1], c.Joints[i]))
+		}
+	}
+
+	return Sub(c.Joints[n-1], target).Magnitude() <= tolerance
+}
+
+// place positions joint i+1 from joint i in the direction of dir, applying
+// the angle constraint of bone i
+func (c *IKChain) place(i int, dir Vector) {
+	dir.Unit()
+
+	if max, ok := c.maxAngle(i); ok {
+		prev := Sub(c.Joints[i], c.Joints[i-1]).Unit()
+		dir = constrainAngle(dir, prev, max)
+	}
+
+	c.Joints[i+1] = dir.Scale(c.Lengths[i]).Add(c.Joints[i])
+}
+
+// maxAngle returns the limit of how far bone i can bend away from bone i-1
+func (c *IKChain) maxAngle(i int) (float64, bool) {
+	if i < 1 || i >= len(c.MaxAngles) || c.MaxAngles[i] < 0 {
+		return 0, false
+	}
+
+	return c.MaxAngles[i], true
+}
+
+// constrainAngle rotates the unit vector dir towards the unit vector ref
+// until the angle between them is at most max radians
+func constrainAngle(dir, ref Vector, max float64) Vector {
+	cos := Dot(dir, ref)
+
+	if cos >= math.Cos(max) {
+		return dir
+	}
+
+	perp := Sub(dir, Scale(ref, cos))
+
+	if perp.Magnitude() < 1e-8 {
+		return dir
+	}
+
+	sin, cos := math.Sincos(max)
+	return Scale(ref, cos).Add(perp.Unit().Scale(sin))
+}
@@ -0,0 +1,90 @@
+package vector_test
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/kvartborg/vector"
+)
+
+func TestIKChainSolve(t *testing.T) {
+	c := vector.NewIKChain(vec{0, 0}, vec{1, 0}, vec{2, 0}, vec{3, 0})
+	target := vec{1, 2}
+
+	if !c.Solve(target, 1e-6, 100) {
+		t.Fatalf("expected the target to be reached, end at %v", c.Joints[3])
+	}
+
+	if !c.Joints[0].Equal(vec{0, 0}) {
+		t.Errorf("expected the root to stay in place, got %v", c.Joints[0])
+	}
+
+	for i := 1; i < len(c.Joints); i++ {
+		if l := vector.Sub(c.Joints[i], c.Joints[i-1]).Magnitude(); math.Abs(l-1) > 1e-6 {
+			t.Errorf("expected bone %d to keep its length, got %v", i-1, l)
+		}
+	}
+}
+
+func TestIKChainOutOfReach(t *testing.T) {
+	c := vector.NewIKChain(vec{0, 0}, vec{0, 1}, vec{1, 1})
+
+	if c.Solve(vec{10, 0}, 1e-6, 10) {
+		t.Error("expected an unreachable target to not be reached")
+	}
+
+	if !c.Joints[2].Equal(vec{2, 0}) {
+		t.Errorf("expected the chain to stretch towards the target, got %v", c.Joints)
+	}
+}
+
+func TestIKChainMaxAngles(t *testing.T) {
+	c := vector.NewIKChain(vec{0, 0}, vec{1, 0}, vec{2, 0})
+	c.MaxAngles = []float64{-1, math.Pi / 4}
+	c.Solve(vec{0, 1}, 1e-6, 50)
+
+	first := vector.Sub(c.Joints[1], c.Joints[0]).Unit()
+	second := vector.Sub(c.Joints[2], c.Joints[1]).Unit()
+
+	if angle := math.Acos(vector.Dot(first, second)); angle > math.Pi/4+1e-6 {
+		t.Errorf("expected the joint to bend at most 45 degrees, got %v", angle)
+	}
+}
+
+func TestIKChainCopiesJoints(t *testing.T) {
+	joints := []vec{{0, 0}, {1, 0}, {2, 0}}
+	c := vector.NewIKChain(joints...)
+	c.Solve(vec{1, 1}, 1e-6, 50)
+
+	if !joints[1].Equal(vec{1, 0}) || !joints[2].Equal(vec{2, 0}) {
+		t.Errorf("expected the joints given to NewIKChain to be unchanged, got %v", joints)
+	}
+}
+
+func TestIKChainMaxAnglesAllJoints(t *testing.T) {
+	for _, target := range []vec{{0, 2}, {-1, 1}, {1, -2}, {2.5, 0.5}} {
+		c := vector.NewIKChain(vec{0, 0}, vec{1, 0}, vec{2, 0}, vec{3, 0})
+		c.MaxAngles = []float64{-1, math.Pi / 3, math.Pi / 6}
+		c.Solve(target, 1e-6, 50)
+
+		for i := 1; i < len(c.Lengths); i++ {
+			prev := vector.Sub(c.Joints[i], c.Joints[i-1]).Unit()
+			bone := vector.Sub(c.Joints[i+1], c.Joints[i]).Unit()
+
+			if angle := math.Acos(math.Min(1, vector.Dot(prev, bone))); angle > c.MaxAngles[i]+1e-6 {
+				t.Errorf("expected bone %d to bend at most %v towards %v, got %v", i, c.MaxAngles[i], target, angle)
+			}
+		}
+	}
+}
+
+func ExampleIKChain_Solve() {
+	c := vector.NewIKChain(vec{0, 0}, vec{3, 0}, vec{6, 0})
+
+	fmt.Println(
+		c.Solve(vec{3, 4}, 1e-6, 100),
+		c.Joints[0],
+	)
+	// Output: true [0 0]
+}
@@ -0,0 +1,54 @@
+package vector
+
+// AccelerationFunc returns the acceleration of a body at a given position
+// and velocity
+type AccelerationFunc func(position, velocity Vector) Vector
+
+// Integrator advances the position and velocity of a body by a time step of
+// dt, returning the new position and velocity. Euler, SemiImplicitEuler,
+// Verlet and RK4 are integrators, which allows the scheme to be swapped.
+type Integrator func(position, velocity Vector, acc AccelerationFunc, dt float64) (Vector, Vector)
+
+// Euler advances a body using the explicit Euler method, it is the cheapest
+// integrator but gains energy over time and can become unstable
+func Euler(position, velocity Vector, acc AccelerationFunc, dt float64) (Vector, Vector) {
+	a := acc(position, velocity)
+	return AddScaled(position, velocity, dt), AddScaled(velocity, a, dt)
+}
+
+// SemiImplicitEuler advances a body using the semi-implicit Euler method,
+// the velocity is updated before the position which makes it much more
+// stable than Euler at the same cost
+func SemiImplicitEuler(position, velocity Vector, acc AccelerationFunc, dt float64) (Vector, Vector) {
+	v := AddScaled(velocity, acc(position, velocity), dt)
+	return AddScaled(position, v, dt), v
+}
+
+// Verlet advances a body using the velocity Verlet method, it is second
+// order accurate and conserves energy well for forces that only depends on
+// the position
+func Verlet(position, velocity Vector, acc AccelerationFunc, dt float64) (Vector, Vector) {
+	a0 := acc(position, velocity)
+	p := AddScaled(AddScaled(position, velocity, dt), a0, dt*dt/2)
+	a1 := acc(p, AddScaled(velocity, a0, dt))
+
+	return p, AddScaled(velocity, Add(a0, a1), dt/2)
+}
+
+// RK4 advances a body using the classic fourth order Runge-Kutta method, it
+// is the most accurate of the integrators but evaluates the acceleration
+// four times per step
+func RK4(position, velocity Vector, acc AccelerationFunc, dt float64) (Vector, Vector) {
+	k1p, k1v := velocity, acc(position, velocity)
+	k2p := AddScaled(velocity, k1v, dt/2)
+	k2v := acc(AddScaled(position, k1p, dt/2), k2p)
+	k3p := AddScaled(velocity, k2v, dt/2)
+	k3v := acc(AddScaled(position, k2p, dt/2), k3p)
+	k4p := AddScaled(velocity, k3v, dt)
+	k4v := acc(AddScaled(position, k3p, dt), k4p)
+
+	p := AddScaled(position, Add(k1p, k4p).Add(Scale(k2p, 2), Scale(k3p, 2)), dt/6)
+	v := AddScaled(velocity, Add(k1v, k4v).Add(Scale(k2v, 2), Scale(k3v, 2)), dt/6)
+
+	return p, v
+}
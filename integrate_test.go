@@ -0,0 +1,70 @@
+package vector_test
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/kvartborg/vector"
+)
+
+func TestIntegrators(t *testing.T) {
+	gravity := vec{0, -10}
+	constant := func(position, velocity vec) vec { return gravity }
+
+	for name, step := range map[string]vector.Integrator{
+		"Verlet": vector.Verlet,
+		"RK4":    vector.RK4,
+	} {
+		p, v := vec{0, 0}, vec{1, 10}
+
+		for i := 0; i < 100; i++ {
+			p, v = step(p, v, constant, 0.01)
+		}
+
+		// constant acceleration is integrated exactly by second order methods
+		if !p.Equal(vec{1, 5}) || !v.Equal(vec{1, 0}) {
+			t.Errorf("%s: expected [1 5] [1 0], got %v %v", name, p, v)
+		}
+	}
+
+	if !gravity.Equal(vec{0, -10}) {
+		t.Error("acceleration was modified by an integrator")
+	}
+}
+
+func TestIntegratorsOscillator(t *testing.T) {
+	// a spring with a period of 2π should return to its start after one period
+	spring := func(position, velocity vec) vec { return vector.Scale(position, -1) }
+	steps := 1000
+	dt := 2 * math.Pi / float64(steps)
+
+	for name, c := range map[string]struct {
+		step      vector.Integrator
+		tolerance float64
+	}{
+		"Euler":             {vector.Euler, 0.5},
+		"SemiImplicitEuler": {vector.SemiImplicitEuler, 0.01},
+		"Verlet":            {vector.Verlet, 1e-3},
+		"RK4":               {vector.RK4, 1e-8},
+	} {
+		p, v := vec{1}, vec{0}
+
+		for i := 0; i < steps; i++ {
+			p, v = c.step(p, v, spring, dt)
+		}
+
+		if math.Abs(p[0]-1) > c.tolerance || math.Abs(v[0]) > c.tolerance {
+			t.Errorf("%s: expected [1] [0], got %v %v", name, p, v)
+		}
+	}
+}
+
+func ExampleSemiImplicitEuler() {
+	gravity := func(position, velocity vec) vec { return vec{0, -10} }
+
+	fmt.Println(
+		vector.SemiImplicitEuler(vec{0, 0}, vec{1, 0}, gravity, 0.5),
+	)
+	// Output: [0.5 -2.5] [1 -5]
+}
@@ -0,0 +1,36 @@
+package vector
+
+// Vec2 is a 2-dimensional point with the same underlying type as the vector
+// types of the go ports of the box2d and chipmunk physics engines,
+// box2d.B2Vec2 and cp.Vector. This allows the physics types to be converted
+// to and from a vector without importing the engines in this package.
+//
+//	body.SetLinearVelocity(box2d.B2Vec2(v.Vec2()))
+//	v := vector.FromVec2(vector.Vec2(space.Gravity()))
+type Vec2 struct {
+	X, Y float64
+}
+
+// FromVec2 creates a 2-dimensional vector from a Vec2
+func FromVec2(p Vec2) Vector {
+	return Vector{p.X, p.Y}
+}
+
+// Vec2 returns the x and y components of the vector as a Vec2, missing
+// components will be 0
+func (v Vector) Vec2() Vec2 {
+	return Vec2{v.X(), v.Y()}
+}
+
+// FromComplex creates a 2-dimensional vector from a complex number, the real
+// part becomes x and the imaginary part becomes y
+func FromComplex(c complex128) Vector {
+	return Vector{real(c), imag(c)}
+}
+
+// ToComplex returns the x and y components of the vector as a complex
+// number, multiplying it with another complex number rotates and scales the
+// vector in the plane
+func (v Vector) ToComplex() complex128 {
+	return complex(v.X(), v.Y())
+}
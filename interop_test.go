@@ -0,0 +1,43 @@
+package vector_test
+
+import (
+	"fmt"
+
+	"github.com/kvartborg/vector"
+)
+
+func ExampleFromVec2() {
+	// a type with the same layout as box2d.B2Vec2 and cp.Vector
+	type B2Vec2 struct {
+		X float64
+		Y float64
+	}
+
+	fmt.Println(
+		vector.FromVec2(vector.Vec2(B2Vec2{1, 2})),
+	)
+	// Output: [1 2]
+}
+
+func ExampleVector_Vec2() {
+	fmt.Println(
+		vec{1, 2, 3}.Vec2(),
+		vec{1}.Vec2(),
+	)
+	// Output: {1 2} {1 0}
+}
+
+func ExampleFromComplex() {
+	fmt.Println(
+		vector.FromComplex(2 + 3i),
+	)
+	// Output: [2 3]
+}
+
+func ExampleVector_ToComplex() {
+	// rotate 90 degrees by multiplying with i
+	fmt.Println(
+		vector.FromComplex(vec{1, 2}.ToComplex() * 1i),
+	)
+	// Output: [-2 1]
+}
@@ -0,0 +1,131 @@
+package vector
+
+import "errors"
+
+var (
+	// ErrMatrixDimension is returned when the dimensions of matrices does not
+	// fit the operation
+	ErrMatrixDimension = errors.New("matrix dimensions does not match")
+)
+
+// Matrix is a matrix of any size stored in row-major order, vectors are
+// treated as column vectors when they are multiplied with the matrix
+type Matrix struct {
+	Rows, Cols int
+	Data       []float64
+}
+
+// NewMatrix returns a zero matrix with the given number of rows and columns
+func NewMatrix(rows, cols int) Matrix {
+	return Matrix{rows, cols, make([]float64, rows*cols)}
+}
+
+// MatrixFromRows returns a matrix with the given vectors as its rows, the
+// number of columns is the dimension of the largest vector and missing
+// components are 0
+func MatrixFromRows(rows ...Vector) Matrix {
+	cols := 0
+
+	for _, r := range rows {
+		if len(r) > cols {
+			cols = len(r)
+		}
+	}
+
+	m := NewMatrix(len(rows), cols)
+
+	for i, r := range rows {
+		copy(m.Data[i*cols:], r)
+	}
+
+	return m
+}
+
+// Identity returns the n x n identity matrix
+func Identity(n int) Matrix {
+	m := NewMatrix(n, n)
+
+	for i := 0; i < n; i++ {
+		m.Data[i*n+i] = 1
+	}
+
+	return m
+}
+
+// At returns the value at the given row and column
+func (m Matrix) At(row, col int) float64 {
+	return m.Data[row*m.Cols+col]
+}
+
+// Set sets the value at the given row and column
+func (m Matrix) Set(row, col int, value float64) {
+	m.Data[row*m.Cols+col] = value
+}
+
+// Row returns a copy of a row of the matrix as a vector
+func (m Matrix) Row(row int) Vector {
+	return Vector(m.Data[row*m.Cols : (row+1)*m.Cols]).Clone()
+}
+
+// Col returns a copy of a column of the matrix as a vector
+func (m Matrix) Col(col int) Vector {
+	v := make(Vector, m.Rows)
+
+	for i := range v {
+		v[i] = m.At(i, col)
+	}
+
+	return v
+}
+
+// MulVec multiplies the matrix with a vector and returns a vector with a
+// component for each row, missing components of the vector are treated as 0
+// and extra components are ignored
+func (m Matrix) MulVec(v Vector) Vector {
+	result := make(Vector, m.Rows)
+
+	for row := range result {
+		for col := 0; col < m.Cols && col < len(v); col++ {
+			result[row] += m.Data[row*m.Cols+col] * v[col]
+		}
+	}
+
+	return result
+}
+
+// Mul multiplies the matrix with another matrix, the result applies n before
+// m. The number of columns of m must match the number of rows of n.
+func (m Matrix) Mul(n Matrix) (Matrix, error) {
+	if m.Cols != n.Rows {
+		return Matrix{}, ErrMatrixDimension
+	}
+
+	result := NewMatrix(m.Rows, n.Cols)
+
+	for row := 0; row < m.Rows; row++ {
+		for col := 0; col < n.Cols; col++ {
+			var sum float64
+
+			for i := 0; i < m.Cols; i++ {
+				sum += m.Data[row*m.Cols+i] * n.Data[i*n.Cols+col]
+			}
+
+			result.Data[row*n.Cols+col] = sum
+		}
+	}
+
+	return result, nil
+}
+
+// Transpose returns the matrix with its rows and columns swapped
+func (m Matrix) Transpose() Matrix {
+	t := NewMatrix(m.Cols, m.Rows)
+
+	for row := 0; row < m.Rows; row++ {
+		for col := 0; col < m.Cols; col++ {
+			t.Data[col*m.Rows+row] = m.Data[row*m.Cols+col]
+		}
+	}
+
+	return t
+}
@@ -0,0 +1,127 @@
+package vector
+
+import "math"
+
+// Matrix3 is a 3x3 matrix stored in row-major order, vectors are treated as
+// column vectors when they are multiplied with the matrix. It is used for
+// rotations and orientation bases in 3 dimensions.
+type Matrix3 [9]float64
+
+// Identity3 returns the 3x3 identity matrix
+func Identity3() Matrix3 {
+	return Matrix3{
+		1, 0, 0,
+		0, 1, 0,
+		0, 0, 1,
+	}
+}
+
+// Matrix3FromAxisAngle returns a matrix that rotates counterclockwise by
+// angle radians around an axis, the axis does not need to have a length of
+// one. A zero axis gives the identity matrix.
+func Matrix3FromAxisAngle(axis Vector, angle float64) Matrix3 {
+	k := Vector{axis.X(), axis.Y(), axis.Z()}
+
+	if k.Magnitude() < 1e-8 {
+		return Identity3()
+	}
+
+	k.Unit()
+	x, y, z := k[X], k[Y], k[Z]
+	sin, cos := math.Sincos(angle)
+	t := 1 - cos
+
+	return Matrix3{
+		t*x*x + cos, t*x*y - sin*z, t*x*z + sin*y,
+		t*x*y + sin*z, t*y*y + cos, t*y*z - sin*x,
+		t*x*z - sin*y, t*y*z + sin*x, t*z*z + cos,
+	}
+}
+
+// Matrix3FromEuler returns a matrix that rotates by the given angles in
+// radians around the x, y and z axis, in that order. This matches calling
+// Rotate with the x, y and z axis one after another.
+func Matrix3FromEuler(x, y, z float64) Matrix3 {
+	sx, cx := math.Sincos(x)
+	sy, cy := math.Sincos(y)
+	sz, cz := math.Sincos(z)
+
+	return Matrix3{
+		cz * cy, cz*sy*sx - sz*cx, cz*sy*cx + sz*sx,
+		sz * cy, sz*sy*sx + cz*cx, sz*sy*cx - cz*sx,
+		-sy, cy * sx, cy * cx,
+	}
+}
+
+// Matrix3FromBasis returns a matrix with the given axis as its columns, it
+// transforms vectors from the local space of the basis into world space
+func Matrix3FromBasis(x, y, z Vector) Matrix3 {
+	return Matrix3{
+		x.X(), y.X(), z.X(),
+		x.Y(), y.Y(), z.Y(),
+		x.Z(), y.Z(), z.Z(),
+	}
+}
+
+// At returns the value at the given row and column
+func (m Matrix3) At(row, col int) float64 {
+	return m[row*3+col]
+}
+
+// Row returns a row of the matrix as a vector
+func (m Matrix3) Row(row int) Vector {
+	return Vector{m[row*3], m[row*3+1], m[row*3+2]}
+}
+
+// Col returns a column of the matrix as a vector, for a rotation matrix the
+// columns are the rotated x, y and z axis
+func (m Matrix3) Col(col int) Vector {
+	return Vector{m[col], m[3+col], m[6+col]}
+}
+
+// Mul multiplies the matrix with another matrix, the result applies n before m
+func (m Matrix3) Mul(n Matrix3) Matrix3 {
+	var result Matrix3
+
+	for row := 0; row < 3; row++ {
+		for col := 0; col < 3; col++ {
+			for i := 0; i < 3; i++ {
+				result[row*3+col] += m[row*3+i] * n[i*3+col]
+			}
+		}
+	}
+
+	return result
+}
+
+// MulVec multiplies the matrix with a 3-dimensional vector, missing
+// components of the vector are treated as 0
+func (m Matrix3) MulVec(v Vector) Vector {
+	x, y, z := v.X(), v.Y(), v.Z()
+
+	return Vector{
+		m[0]*x + m[1]*y + m[2]*z,
+		m[3]*x + m[4]*y + m[5]*z,
+		m[6]*x + m[7]*y + m[8]*z,
+	}
+}
+
+// Transpose returns the matrix with its rows and columns swapped, for a
+// rotation matrix it is the inverse rotation
+func (m Matrix3) Transpose() Matrix3 {
+	return Matrix3{
+		m[0], m[3], m[6],
+		m[1], m[4], m[7],
+		m[2], m[5], m[8],
+	}
+}
+
+// Matrix4 returns the matrix as the upper left part of a 4x4 matrix
+func (m Matrix3) Matrix4() Matrix4 {
+	return Matrix4{
+		m[0], m[1], m[2], 0,
+		m[3], m[4], m[5], 0,
+		m[6], m[7], m[8], 0,
+		0, 0, 0, 1,
+	}
+}
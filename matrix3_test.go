@@ -0,0 +1,47 @@
+package vector_test
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/kvartborg/vector"
+)
+
+func TestMatrix3FromEulerMatchesRotate(t *testing.T) {
+	v := vec{1, 2, 3}
+	expected := v.Clone().Rotate(0.3, vector.X).Rotate(-0.5, vector.Y).Rotate(1.1, vector.Z)
+
+	if result := vector.Matrix3FromEuler(0.3, -0.5, 1.1).MulVec(v); !result.Equal(expected) {
+		t.Errorf("expected %v, got %v", expected, result)
+	}
+}
+
+func TestMatrix3Transpose(t *testing.T) {
+	m := vector.Matrix3FromAxisAngle(vec{1, 1, 0}, 0.8)
+	result, identity := m.Mul(m.Transpose()), vector.Identity3()
+
+	if !vec(result[:]).Equal(vec(identity[:])) {
+		t.Errorf("expected a rotation times its transpose to be the identity, got %v", result)
+	}
+}
+
+func ExampleMatrix3FromAxisAngle() {
+	m := vector.Matrix3FromAxisAngle(vec{0, 0, 1}, math.Pi/2)
+
+	fmt.Println(
+		m.MulVec(vec{1, 0, 0}).Equal(vec{0, 1, 0}),
+	)
+	// Output: true
+}
+
+func ExampleMatrix3FromBasis() {
+	m := vector.Matrix3FromBasis(vec{0, 1, 0}, vec{-1, 0, 0}, vec{0, 0, 1})
+
+	fmt.Println(
+		m.MulVec(vec{2, 0, 0}),
+		m.Col(1),
+		m.Row(0),
+	)
+	// Output: [0 2 0] [-1 0 0] [0 -1 0]
+}
@@ -0,0 +1,195 @@
+package vector
+
+import (
+	"errors"
+	"math"
+)
+
+var (
+	// ErrSingularMatrix is returned when trying to invert a matrix that has
+	// no inverse
+	ErrSingularMatrix = errors.New("matrix is not invertible")
+)
+
+// Matrix4 is a 4x4 matrix stored in row-major order, vectors are treated as
+// column vectors when they are multiplied with the matrix.
+type Matrix4 [16]float64
+
+// Identity4 returns the 4x4 identity matrix
+func Identity4() Matrix4 {
+	return Matrix4{
+		1, 0, 0, 0,
+		0, 1, 0, 0,
+		0, 0, 1, 0,
+		0, 0, 0, 1,
+	}
+}
+
+// Perspective returns a right-handed perspective projection matrix that maps
+// the view frustum into normalized device coordinates in the range [-1, 1].
+// The vertical field of view is in radians.
+func Perspective(fovy, aspect, near, far float64) Matrix4 {
+	f := 1 / math.Tan(fovy/2)
+
+	return Matrix4{
+		f / aspect, 0, 0, 0,
+		0, f, 0, 0,
+		0, 0, (far + near) / (near - far), 2 * far * near / (near - far),
+		0, 0, -1, 0,
+	}
+}
+
+// Orthographic returns a right-handed orthographic projection matrix that
+// maps the given box into normalized device coordinates in the range [-1, 1]
+func Orthographic(left, right, bottom, top, near, far float64) Matrix4 {
+	return Matrix4{
+		2 / (right - left), 0, 0, -(right + left) / (right - left),
+		0, 2 / (top - bottom), 0, -(top + bottom) / (top - bottom),
+		0, 0, -2 / (far - near), -(far + near) / (far - near),
+		0, 0, 0, 1,
+	}
+}
+
+// At returns the value at the given row and column
+func (m Matrix4) At(row, col int) float64 {
+	return m[row*4+col]
+}
+
+// Mul multiplies the matrix with another matrix, the result applies n before m
+func (m Matrix4) Mul(n Matrix4) Matrix4 {
+	var result Matrix4
+
+	for row := 0; row < 4; row++ {
+		for col := 0; col < 4; col++ {
+			for i := 0; i < 4; i++ {
+				result[row*4+col] += m[row*4+i] * n[i*4+col]
+			}
+		}
+	}
+
+	return result
+}
+
+// MulVec multiplies the matrix with a 4-dimensional vector, missing
+// components of the vector are treated as 0
+func (m Matrix4) MulVec(v Vector) Vector {
+	x, y, z, w := m.mul4(v.X(), v.Y(), v.Z(), v.W())
+	return Vector{x, y, z, w}
+}
+
+// Inverse returns the inverse of the matrix
+func (m Matrix4) Inverse() (Matrix4, error) {
+	var inv Matrix4
+
+	inv[0] = m[5]*m[10]*m[15] - m[5]*m[11]*m[14] - m[9]*m[6]*m[15] + m[9]*m[7]*m[14] + m[13]*m[6]*m[11] - m[13]*m[7]*m[10]
+	inv[4] = -m[4]*m[10]*m[15] + m[4]*m[11]*m[14] + m[8]*m[6]*m[15] - m[8]*m[7]*m[14] - m[12]*m[6]*m[11] + m[12]*m[7]*m[10]
+	inv[8] = m[4]*m[9]*m[15] - m[4]*m[11]*m[13] - m[8]*m[5]*m[15] + m[8]*m[7]*m[13] + m[12]*m[5]*m[11] - m[12]*m[7]*m[9]
+	inv[12] = -m[4]*m[9]*m[14] + m[4]*m[10]*m[13] + m[8]*m[5]*m[14] - m[8]*m[6]*m[13] - m[12]*m[5]*m[10] + m[12]*m[6]*m[9]
+	inv[1] = -m[1]*m[10]*m[15] + m[1]*m[11]*m[14] + m[9]*m[2]*m[15] - m[9]*m[3]*m[14] - m[13]*m[2]*m[11] + m[13]*m[3]*m[10]
+	inv[5] = m[0]*m[10]*m[15] - m[0]*m[11]*m[14] - m[8]*m[2]*m[15] + m[8]*m[3]*m[14] + m[12]*m[2]*m[11] - m[12]*m[3]*m[10]
+	inv[9] = -m[0]*m[9]*m[15] + m[0]*m[11]*m[13] + m[8]*m[1]*m[15] - m[8]*m[3]*m[13] - m[12]*m[1]*m[11] + m[12]*m[3]*m[9]
+	inv[13] = m[0]*m[9]*m[14] - m[0]*m[10]*m[13] - m[8]*m[1]*m[14] + m[8]*m[2]*m[13] + m[12]*m[1]*m[10] - m[12]*m[2]*m[9]
+	inv[2] = m[1]*m[6]*m[15] - m[1]*m[7]*m[14] - m[5]*m[2]*m[15] + m[5]*m[3]*m[14] + m[13]*m[2]*m[7] - m[13]*m[3]*m[6]
+	inv[6] = -m[0]*m[6]*m[15] + m[0]*m[7]*m[14] + m[4]*m[2]*m[15] - m[4]*m[3]*m[14] - m[12]*m[2]*m[7] + m[12]*m[3]*m[6]
+	inv[10] = m[0]*m[5]*m[15] - m[0]*m[7]*m[13] - m[4]*m[1]*m[15] + m[4]*m[3]*m[13] + m[12]*m[1]*m[7] - m[12]*m[3]*m[5]
+	inv[14] = -m[0]*m[5]*m[14] + m[0]*m[6]*m[13] + m[4]*m[1]*m[14] - m[4]*m[2]*m[13] - m[12]*m[1]*m[6] + m[12]*m[2]*m[5]
+	inv[3] = -m[1]*m[6]*m[11] + m[1]*m[7]*m[10] + m[5]*m[2]*m[11] - m[5]*m[3]*m[10] - m[9]*m[2]*m[7] + m[9]*m[3]*m[6]
+	inv[7] = m[0]*m[6]*m[11] - m[0]*m[7]*m[10] - m[4]*m[2]*m[11] + m[4]*m[3]*m[10] + m[8]*m[2]*m[7] - m[8]*m[3]*m[6]
+	inv[11] = -m[0]*m[5]*m[11] + m[0]*m[7]*m[9] + m[4]*m[1]*m[11] - m[4]*m[3]*m[9] - m[8]*m[1]*m[7] + m[8]*m[3]*m[5]
+	inv[15] = m[0]*m[5]*m[10] - m[0]*m[6]*m[9] - m[4]*m[1]*m[10] + m[4]*m[2]*m[9] + m[8]*m[1]*m[6] - m[8]*m[2]*m[5]
+
+	det := m[0]*inv[0] + m[1]*inv[4] + m[2]*inv[8] + m[3]*inv[12]
+
+	if math.Abs(det) < 1e-12 {
+		return Matrix4{}, ErrSingularMatrix
+	}
+
+	for i := range inv {
+		inv[i] /= det
+	}
+
+	return inv, nil
+}
+
+// Translation4 returns a matrix that moves points by the offset v
+func Translation4(v Vector) Matrix4 {
+	return Matrix4{
+		1, 0, 0, v.X(),
+		0, 1, 0, v.Y(),
+		0, 0, 1, v.Z(),
+		0, 0, 0, 1,
+	}
+}
+
+// Scaling4 returns a matrix that scales each axis by the component of v of
+// the same axis, missing components scales by 1
+func Scaling4(v Vector) Matrix4 {
+	s := Vector{1, 1, 1}
+	copy(s, v)
+
+	return Matrix4{
+		s[X], 0, 0, 0,
+		0, s[Y], 0, 0,
+		0, 0, s[Z], 0,
+		0, 0, 0, 1,
+	}
+}
+
+// Rotation4 returns a matrix that rotates counterclockwise by angle radians
+// around an axis through the origin, the axis does not need to have a
+// length of one. A zero axis gives the identity matrix.
+func Rotation4(axis Vector, angle float64) Matrix4 {
+	return Matrix3FromAxisAngle(axis, angle).Matrix4()
+}
+
+// Translate returns the matrix followed by a translation, which allows
+// transforms to be composed in the order they are applied, like
+// Identity4().Scale(s).Rotate(axis, angle).Translate(position)
+func (m Matrix4) Translate(v Vector) Matrix4 {
+	return Translation4(v).Mul(m)
+}
+
+// Scale returns the matrix followed by a scale of each axis
+func (m Matrix4) Scale(v Vector) Matrix4 {
+	return Scaling4(v).Mul(m)
+}
+
+// Rotate returns the matrix followed by a rotation around an axis
+func (m Matrix4) Rotate(axis Vector, angle float64) Matrix4 {
+	return Rotation4(axis, angle).Mul(m)
+}
+
+// TransformPoint applies the matrix to a 3-dimensional point and returns
+// the transformed point, the result is divided by w for projective matrices
+func (m Matrix4) TransformPoint(v Vector) Vector {
+	x, y, z, w := m.mul4(v.X(), v.Y(), v.Z(), 1)
+
+	if w != 1 && w != 0 {
+		return Vector{x / w, y / w, z / w}
+	}
+
+	return Vector{x, y, z}
+}
+
+// TransformDirection applies the matrix to a 3-dimensional direction, unlike
+// TransformPoint the translation of the matrix is ignored
+func (m Matrix4) TransformDirection(v Vector) Vector {
+	x, y, z, _ := m.mul4(v.X(), v.Y(), v.Z(), 0)
+	return Vector{x, y, z}
+}
+
+func (m Matrix4) mul4(x, y, z, w float64) (float64, float64, float64, float64) {
+	return m[0]*x + m[1]*y + m[2]*z + m[3]*w,
+		m[4]*x + m[5]*y + m[6]*z + m[7]*w,
+		m[8]*x + m[9]*y + m[10]*z + m[11]*w,
+		m[12]*x + m[13]*y + m[14]*z + m[15]*w
+}
+
+// at returns the component at index i, or 0 if the vector is too short
+func at(v Vector, i int) float64 {
+	if len(v) <= i {
+		return 0
+	}
+
+	return v[i]
+}
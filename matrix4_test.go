@@ -0,0 +1,74 @@
+package vector_test
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/kvartborg/vector"
+)
+
+func TestMatrix4Inverse(t *testing.T) {
+	m := vector.Perspective(math.Pi/4, 2, 1, 10)
+	inv, err := m.Inverse()
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !matrix4Equal(m.Mul(inv), vector.Identity4()) {
+		t.Error("matrix multiplied with its inverse is not the identity")
+	}
+
+	if _, err := (vector.Matrix4{}).Inverse(); err != vector.ErrSingularMatrix {
+		t.Error("expected zero matrix to be singular")
+	}
+}
+
+func matrix4Equal(m, n vector.Matrix4) bool {
+	return vec(m[:]).Equal(vec(n[:]))
+}
+
+func TestMatrix4Composition(t *testing.T) {
+	m := vector.Identity4().
+		Scale(vec{2, 2, 2}).
+		Rotate(vec{0, 0, 1}, math.Pi/2).
+		Translate(vec{10, 0, 0})
+
+	if p := m.TransformPoint(vec{1, 0, 0}); !p.Equal(vec{10, 2, 0}) {
+		t.Errorf("expected [10 2 0], got %v", p)
+	}
+
+	if d := m.TransformDirection(vec{1, 0, 0}); !d.Equal(vec{0, 2, 0}) {
+		t.Errorf("expected [0 2 0], got %v", d)
+	}
+
+	inv, err := m.Inverse()
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if p := inv.TransformPoint(vec{10, 2, 0}); !p.Equal(vec{1, 0, 0}) {
+		t.Errorf("expected the inverse to undo the transform, got %v", p)
+	}
+}
+
+func TestRotation4MatchesRotateAroundAxisPoint(t *testing.T) {
+	axis, v := vec{1, 2, 3}, vec{3, -1, 2}
+	expected := vector.RotateAroundAxisPoint(v, axis, nil, 0.7)
+
+	if p := vector.Rotation4(axis, 0.7).TransformPoint(v); !p.Equal(expected) {
+		t.Errorf("expected %v, got %v", expected, p)
+	}
+}
+
+func ExampleMatrix4_TransformPoint() {
+	m := vector.Translation4(vec{1, 2, 3})
+
+	fmt.Println(
+		m.TransformPoint(vec{1, 1, 1}),
+		m.TransformDirection(vec{1, 1, 1}),
+	)
+	// Output: [2 3 4] [1 1 1]
+}
@@ -0,0 +1,50 @@
+package vector_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/kvartborg/vector"
+)
+
+func TestMatrixMul(t *testing.T) {
+	m := vector.MatrixFromRows(vec{1, 2, 3}, vec{4, 5, 6})
+	n := vector.MatrixFromRows(vec{1, 0}, vec{0, 1}, vec{1, 1})
+	result, err := m.Mul(n)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if result.Rows != 2 || result.Cols != 2 || !vec(result.Data).Equal(vec{4, 5, 10, 11}) {
+		t.Errorf("unexpected result %v", result)
+	}
+
+	if _, err := m.Mul(m); err != vector.ErrMatrixDimension {
+		t.Errorf("expected ErrMatrixDimension, got %v", err)
+	}
+}
+
+func TestMatrixIdentity(t *testing.T) {
+	v := vec{1, 2, 3, 4, 5}
+
+	if result := vector.Identity(5).MulVec(v); !result.Equal(v) {
+		t.Errorf("expected the identity to not change %v, got %v", v, result)
+	}
+}
+
+func ExampleMatrix_MulVec() {
+	shear := vector.MatrixFromRows(vec{1, 0.5}, vec{0, 1})
+
+	fmt.Println(
+		shear.MulVec(vec{2, 2}),
+	)
+	// Output: [3 2]
+}
+
+func ExampleMatrix_Transpose() {
+	m := vector.MatrixFromRows(vec{1, 2, 3}, vec{4, 5, 6}).Transpose()
+
+	fmt.Println(m.Row(0), m.Col(0))
+	// Output: [1 4] [1 2 3]
+}
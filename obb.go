@@ -0,0 +1,78 @@
+package vector
+
+import "math"
+
+// OBB is a 3-dimensional oriented bounding box, a box that can be rotated.
+// The columns of the orientation are the local x, y and z axis of the box
+// and are expected to have a length of one, HalfExtents is half the size of
+// the box along each local axis.
+type OBB struct {
+	Center      Vector
+	HalfExtents Vector
+	Orientation Matrix3
+}
+
+// NewOBB creates a box with the given center and half extents that is
+// rotated by a quaternion
+func NewOBB(center, halfExtents Vector, rotation Quaternion) OBB {
+	return OBB{center, halfExtents, rotation.Matrix3()}
+}
+
+// Contains reports if a point is inside the box or on its surface
+func (b OBB) Contains(v Vector) bool {
+	local := b.Orientation.Transpose().MulVec(Sub(Vector{v.X(), v.Y(), v.Z()}, b.Center))
+
+	for i := range local {
+		if math.Abs(local[i]) > at(b.HalfExtents, i)+1e-12 {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Intersects reports if two boxes overlap or touch, using the separating
+// axis theorem
+func (b OBB) Intersects(other OBB) bool {
+	t := Vector{
+		other.Center.X() - b.Center.X(),
+		other.Center.Y() - b.Center.Y(),
+		other.Center.Z() - b.Center.Z(),
+	}
+
+	axes := make([]Vector, 0, 15)
+
+	for i := 0; i < 3; i++ {
+		axes = append(axes, b.Orientation.Col(i), other.Orientation.Col(i))
+	}
+
+	for i := 0; i < 3; i++ {
+		for j := 0; j < 3; j++ {
+			c, _ := Cross(b.Orientation.Col(i), other.Orientation.Col(j))
+
+			// parallel edges gives no new axis to test
+			if c.MagnitudeSquared() > 1e-12 {
+				axes = append(axes, c)
+			}
+		}
+	}
+
+	for _, axis := range axes {
+		if math.Abs(Dot(t, axis)) > b.projectedRadius(axis)+other.projectedRadius(axis) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// projectedRadius returns half the length of the box projected onto an axis
+func (b OBB) projectedRadius(axis Vector) float64 {
+	var r float64
+
+	for i := 0; i < 3; i++ {
+		r += at(b.HalfExtents, i) * math.Abs(Dot(b.Orientation.Col(i), axis))
+	}
+
+	return r
+}
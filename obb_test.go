@@ -0,0 +1,38 @@
+package vector_test
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/kvartborg/vector"
+)
+
+func TestOBBIntersects(t *testing.T) {
+	a := vector.NewOBB(vec{0, 0, 0}, vec{1, 1, 1}, vector.IdentityQuaternion())
+	rotated := vector.QuaternionFromAxisAngle(vec{0, 0, 1}, math.Pi/4)
+
+	// a rotated box reaches √2 from its center along the x axis
+	if !a.Intersects(vector.NewOBB(vec{2.3, 0, 0}, vec{1, 1, 1}, rotated)) {
+		t.Error("expected the corner of the rotated box to reach the other box")
+	}
+
+	if a.Intersects(vector.NewOBB(vec{2.5, 0, 0}, vec{1, 1, 1}, rotated)) {
+		t.Error("expected the rotated box to be out of reach")
+	}
+
+	if !a.Intersects(vector.NewOBB(vec{1.9, 0, 0}, vec{1, 1, 1}, vector.IdentityQuaternion())) {
+		t.Error("expected aligned boxes to intersect")
+	}
+
+}
+
+func ExampleOBB_Contains() {
+	b := vector.NewOBB(vec{0, 0, 0}, vec{2, 0.5, 0.5}, vector.QuaternionFromAxisAngle(vec{0, 0, 1}, math.Pi/2))
+
+	fmt.Println(
+		b.Contains(vec{0, 1.5, 0}),
+		b.Contains(vec{1.5, 0, 0}),
+	)
+	// Output: true false
+}
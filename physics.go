@@ -0,0 +1,285 @@
+package vector
+
+import (
+	"errors"
+	"math"
+)
+
+var (
+	// ErrOutOfRange is returned when a projectile can not reach its target
+	ErrOutOfRange = errors.New("target is out of range")
+)
+
+// Bounce returns the velocity of a body after hitting a surface with the
+// given normal. The velocity is split into a part along the normal, which is
+// reflected and scaled by the restitution, and a part along the surface,
+// which is reduced by the friction. A restitution of 1 and a friction of 0
+// is a perfect bounce. If the body is moving away from the surface the
+// velocity is returned unchanged.
+func Bounce(velocity, normal Vector, restitution, friction float64) Vector {
+	n := Unit(normal)
+	d := Dot(velocity, n)
+
+	if d >= 0 {
+		return velocity.Clone()
+	}
+
+	normalPart := Scale(n, d)
+	result := Sub(velocity, normalPart).Scale(1 - friction)
+
+	return result.Sub(normalPart.Scale(restitution))
+}
+
+// Attraction returns the gravitational force acting on each body in a set of
+// point masses, g is the gravitational constant and softening is added to
+// the distance between bodies to avoid infinite forces when they get close.
+// Charge-like forces can be calculated by passing charges as masses and a
+// negative constant for repelling forces. The forces are calculated by
+// direct summation, which takes O(n²) time.
+func Attraction(positions []Vector, masses []float64, g, softening float64) ([]Vector, error) {
+	if len(positions) != len(masses) {
+		return nil, ErrLengthMismatch
+	}
+
+	forces := make([]Vector, len(positions))
+
+	for i := range positions {
+		forces[i] = make(Vector, len(positions[i]))
+	}
+
+	for i := range positions {
+		for j := i + 1; j < len(positions); j++ {
+			d := Sub(positions[j], positions[i])
+			r2 := Dot(d, d) + softening*softening
+
+			if r2 < 1e-16 {
+				continue
+			}
+
+			d.Scale(g * masses[i] * masses[j] / (r2 * math.Sqrt(r2)))
+			forces[i].Add(d)
+			forces[j].Sub(d)
+		}
+	}
+
+	return forces, nil
+}
+
+// LaunchVelocity returns the velocities a projectile launched from origin
+// with the given speed needs to hit a target under constant gravity. There
+// are two solutions, low is the flat and fast arc and high is the lob. If the
+// target can not be reached with the given speed ErrOutOfRange is returned.
+func LaunchVelocity(origin, target, gravity Vector, speed float64) (low, high Vector, err error) {
+	d := Sub(target, origin)
+	g := gravity.Magnitude()
+
+	if g < 1e-8 {
+		v := Unit(d).Scale(speed)
+		return v, v.Clone(), nil
+	}
+
+	up := Scale(gravity, -1/g)
+	y := Dot(d, up)
+	horizontal := Sub(d, Scale(up, y))
+	x := horizontal.Magnitude()
+	s2 := speed * speed
+
+	if x < 1e-8 {
+		if y > 0 && s2 < 2*g*y {
+			return nil, nil, ErrOutOfRange
+		}
+
+		v := Scale(up, math.Copysign(speed, y))
+		return v, v.Clone(), nil
+	}
+
+	disc := s2*s2 - g*(g*x*x+2*y*s2)
+
+	if disc < 0 {
+		return nil, nil, ErrOutOfRange
+	}
+
+	horizontal.Scale(1 / x)
+	velocity := func(angle float64) Vector {
+		sin, cos := math.Sincos(angle)
+		return Scale(horizontal, speed*cos).Add(Scale(up, speed*sin))
+	}
+
+	root := math.Sqrt(disc)
+	return velocity(math.Atan2(s2-root, g*x)), velocity(math.Atan2(s2+root, g*x)), nil
+}
+
+// Trajectory samples the path of a projectile launched from origin with a
+// velocity under constant gravity, it returns n points spaced dt seconds
+// apart starting at the origin. It can be used to draw aim previews.
+func Trajectory(origin, velocity, gravity Vector, dt float64, n int) []Vector {
+	points := make([]Vector, n)
+
+	for i := range points {
+		t := dt * float64(i)
+		points[i] = AddScaled(AddScaled(origin, velocity, t), gravity, t*t/2)
+	}
+
+	return points
+}
+
+// SpringForce returns the force acting on point a from a damped spring that
+// connects it to point b, following Hooke's law. The force on b is the same
+// force negated. The damping reduces the relative velocity of the points
+// along the spring.
+func SpringForce(a, b Vector, restLength, stiffness, damping float64, velA, velB Vector) Vector {
+	d := Sub(a, b)
+	l := d.Magnitude()
+
+	if l < 1e-8 {
+		return make(Vector, len(a))
+	}
+
+	d.Scale(1 / l)
+	speed := Dot(Sub(velA, velB), d)
+
+	return d.Scale(-stiffness*(l-restLength) - damping*speed)
+}
+
+// Follower smoothly tracks a moving target using a damped harmonic
+// oscillator, the motion is described by a natural frequency in hertz and a
+// damping ratio. Each update uses the exact solution of the oscillator with
+// the target held in place during the step, so the motion is stable for any
+// time step and the same independent of the frame rate.
+type Follower struct {
+	Position, Velocity Vector
+	omega, damping     float64
+}
+
+// NewFollower creates a follower that starts at rest at the given position.
+//
+// The frequency is how fast the follower responds to changes of the target.
+// A damping ratio of 0 makes the follower oscillate forever, between 0 and 1
+// it overshoots and settles, 1 is critically damped and above 1 it approaches
+// the target slowly.
+func NewFollower(position Vector, frequency, damping float64) *Follower {
+	return &Follower{
+		Position: position.Clone(),
+		Velocity: make(Vector, len(position)),
+		omega:    2 * math.Pi * frequency,
+		damping:  damping,
+	}
+}
+
+// Update moves the follower towards the target over a time step of dt and
+// returns a copy of its new position
+func (f *Follower) Update(target Vector, dt float64) Vector {
+	if dt <= 0 {
+		return f.Position.Clone()
+	}
+
+	a, b, c, d := f.coefficients(dt)
+	offset := Sub(f.Position, target)
+
+	// the offset to the target and the velocity after the step are a linear
+	// combination of their values before the step
+	f.Position = Scale(offset, a).Add(Scale(f.Velocity, b)).Add(target)
+	f.Velocity = Scale(offset, c).Add(Scale(f.Velocity, d))
+
+	return f.Position.Clone()
+}
+
+// coefficients returns the solution of the oscillator after t seconds, the
+// offset becomes a*offset + b*velocity and the velocity c*offset + d*velocity
+func (f *Follower) coefficients(t float64) (a, b, c, d float64) {
+	w, z := f.omega, f.damping
+
+	switch {
+	case w == 0 || math.Abs(z-1) < 1e-6:
+		e := math.Exp(-w * t)
+		return e * (1 + w*t), e * t, -e * w * w * t, e * (1 - w*t)
+	case z < 1:
+		wd := w * math.Sqrt(1-z*z)
+		e := math.Exp(-z * w * t)
+		sin, cos := math.Sincos(wd * t)
+		return e * (cos + z*w/wd*sin), e * sin / wd, -e * w * w / wd * sin, e * (cos - z*w/wd*sin)
+	default:
+		root := math.Sqrt(z*z - 1)
+		r1, r2 := -w*(z-root), -w*(z+root)
+		e1, e2 := math.Exp(r1*t), math.Exp(r2*t)
+		return e2 - r2*(e1-e2)/(r1-r2), (e1 - e2) / (r1 - r2), r2*e2 - r2*(r1*e1-r2*e2)/(r1-r2), (r1*e1 - r2*e2) / (r1 - r2)
+	}
+}
+
+// ResolveImpulse returns the velocities of two bodies after a collision. The
+// normal is the collision normal pointing from body a towards body b, if it
+// has a length of zero the direction between the positions is used instead.
+// A restitution of 1 is a perfectly elastic collision and 0 is perfectly
+// inelastic. Static bodies can be given an infinite mass with math.Inf(1).
+// If the bodies are already moving apart the velocities are returned
+// unchanged.
+func ResolveImpulse(posA, velA Vector, massA float64, posB, velB Vector, massB float64, normal Vector, restitution float64) (Vector, Vector) {
+	n := Unit(normal)
+
+	if n.Magnitude() < 1e-8 {
+		n = Sub(posB, posA).Unit()
+	}
+
+	speed := Dot(Sub(velB, velA), n)
+	inv := 1/massA + 1/massB
+
+	if speed >= 0 || inv == 0 {
+		return velA.Clone(), velB.Clone()
+	}
+
+	j := -(1 + restitution) * speed / inv
+
+	return AddScaled(velA, n, -j/massA), AddScaled(velB, n, j/massB)
+}
+
+// ApplyDrag returns the velocity after slowing it down by linear and
+// quadratic drag for dt seconds. Linear drag dominates at low speeds and
+// quadratic drag, like air resistance, dominates at high speeds. The drag
+// equation is solved exactly, so the result is independent of the frame
+// rate and never reverses the direction of the velocity.
+func ApplyDrag(velocity Vector, linear, quadratic, dt float64) Vector {
+	s0 := velocity.Magnitude()
+
+	if s0 < 1e-8 {
+		return velocity.Clone()
+	}
+
+	var s float64
+
+	if linear > 1e-8 {
+		decay := math.Exp(-linear * dt)
+		s = linear * s0 * decay / (linear + quadratic*s0*(1-decay))
+	} else {
+		s = s0 / (1 + quadratic*s0*dt)
+	}
+
+	return Scale(velocity, s/s0)
+}
+
+// Torque returns the torque around the center of a 2-dimensional body when
+// a force is applied at an offset from the center, a positive torque turns
+// the body counterclockwise
+func Torque(offset, force Vector) float64 {
+	return offset.Cross2D(force)
+}
+
+// AngularVelocity returns the angular velocity in radians per second after
+// applying a torque for dt seconds to a body with the given moment of
+// inertia, a body with an inertia of 0 is treated as one that can not rotate
+func AngularVelocity(angularVelocity, torque, inertia, dt float64) float64 {
+	if inertia == 0 {
+		return angularVelocity
+	}
+
+	return angularVelocity + torque/inertia*dt
+}
+
+// PointVelocity returns the linear velocity of a point at an offset from the
+// center of a 2-dimensional body that moves with the given linear velocity
+// and rotates with the given angular velocity
+func PointVelocity(linear Vector, angularVelocity float64, offset Vector) Vector {
+	return Vector{
+		linear.X() - angularVelocity*offset.Y(),
+		linear.Y() + angularVelocity*offset.X(),
+	}
+}
@@ -0,0 +1,207 @@
+package vector_test
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/kvartborg/vector"
+)
+
+func ExampleBounce() {
+	fmt.Println(
+		vector.Bounce(vec{4, -2}, vec{0, 1}, 0.5, 0.25),
+		vector.Bounce(vec{4, 2}, vec{0, 1}, 0.5, 0.25),
+	)
+	// Output: [3 1] [4 2]
+}
+
+func TestAttraction(t *testing.T) {
+	positions := []vec{{0, 0}, {2, 0}, {0, 4}}
+	masses := []float64{1, 2, 4}
+
+	forces, err := vector.Attraction(positions, masses, 1, 0)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !forces[0].Equal(vec{0.5, 0.25}) {
+		t.Errorf("expected [0.5 0.25], got %v", forces[0])
+	}
+
+	// the forces are equal and opposite, so they sum to zero
+	if sum := vector.Add(forces[0], forces[1], forces[2]); !sum.Equal(vec{0, 0}) {
+		t.Errorf("expected forces to sum to zero, got %v", sum)
+	}
+
+	if _, err := vector.Attraction(positions, masses[:2], 1, 0); err != vector.ErrLengthMismatch {
+		t.Error("expected slices of different length to be rejected")
+	}
+}
+
+func TestLaunchVelocity(t *testing.T) {
+	origin, target, gravity := vec{0, 1, 0}, vec{10, 3, 5}, vec{0, -9.8, 0}
+
+	low, high, err := vector.LaunchVelocity(origin, target, gravity, 20)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, v := range []vec{low, high} {
+		if math.Abs(v.Magnitude()-20) > 1e-8 {
+			t.Errorf("expected launch speed of 20, got %v", v.Magnitude())
+		}
+
+		// the time to reach the target horizontally
+		horizontal := math.Hypot(v[0], v[2])
+		duration := math.Hypot(10, 5) / horizontal
+		hit := vector.Trajectory(origin, v, gravity, duration, 2)[1]
+
+		if !hit.Equal(target) {
+			t.Errorf("expected %v to hit %v, got %v", v, target, hit)
+		}
+	}
+
+	if low[1] >= high[1] {
+		t.Errorf("expected low arc to be flatter than high arc, got %v %v", low, high)
+	}
+
+	if _, _, err := vector.LaunchVelocity(origin, vec{1000, 0, 0}, gravity, 20); err != vector.ErrOutOfRange {
+		t.Error("expected target out of range to be rejected")
+	}
+}
+
+func ExampleTrajectory() {
+	fmt.Println(
+		vector.Trajectory(vec{0, 0}, vec{1, 10}, vec{0, -10}, 0.5, 3),
+	)
+	// Output: [[0 0] [0.5 3.75] [1 5]]
+}
+
+func TestSpringForce(t *testing.T) {
+	// a stretched spring pulls the points together
+	if f := vector.SpringForce(vec{3, 4}, vec{0, 0}, 1, 2, 0, vec{0, 0}, vec{0, 0}); !f.Equal(vec{-4.8, -6.4}) {
+		t.Errorf("expected [-4.8 -6.4], got %v", f)
+	}
+
+	// damping resists the points moving apart
+	if f := vector.SpringForce(vec{3, 4}, vec{0, 0}, 5, 2, 0.5, vec{1.2, 1.6}, vec{0, 0}); !f.Equal(vec{-0.6, -0.8}) {
+		t.Errorf("expected [-0.6 -0.8], got %v", f)
+	}
+
+	if f := vector.SpringForce(vec{1, 1}, vec{1, 1}, 1, 2, 0, vec{0, 0}, vec{0, 0}); !f.Equal(vec{0, 0}) {
+		t.Errorf("expected no force between points at the same position, got %v", f)
+	}
+}
+
+func TestFollower(t *testing.T) {
+	for _, dt := range []float64{1. / 240, 1. / 30, 0.5} {
+		f := vector.NewFollower(vec{0, 0}, 2, 1)
+		target := vec{10, -5}
+
+		for i := 0; i < int(20/dt); i++ {
+			f.Update(target, dt)
+		}
+
+		if vector.Sub(f.Position, target).Magnitude() > 1e-3 {
+			t.Errorf("expected follower to settle at the target with dt %v, got %v", dt, f.Position)
+		}
+	}
+
+	f := vector.NewFollower(vec{0, 0}, 2, 1)
+	p := f.Update(vec{1, 1}, 0.1)
+	p[0] = 100
+
+	if f.Position[0] == 100 {
+		t.Error("expected Update to return a copy of the position")
+	}
+}
+
+func TestFollowerFrameRateIndependent(t *testing.T) {
+	for _, damping := range []float64{0, 0.5, 1, 2} {
+		once := vector.NewFollower(vec{0, 0}, 1.5, damping)
+		steps := vector.NewFollower(vec{0, 0}, 1.5, damping)
+		target := vec{10, -5}
+
+		once.Update(target, 0.5)
+
+		for i := 0; i < 50; i++ {
+			steps.Update(target, 0.01)
+		}
+
+		if vector.Sub(once.Position, steps.Position).Magnitude() > 1e-9 || vector.Sub(once.Velocity, steps.Velocity).Magnitude() > 1e-9 {
+			t.Errorf("expected one step to equal many smaller steps with damping %v, got %v %v and %v %v",
+				damping, once.Position, once.Velocity, steps.Position, steps.Velocity)
+		}
+	}
+}
+
+func TestResolveImpulse(t *testing.T) {
+	// equal masses swap velocities in an elastic collision
+	a, b := vector.ResolveImpulse(vec{0, 0}, vec{1, 0}, 1, vec{1, 0}, vec{-1, 0}, 1, vec{1, 0}, 1)
+
+	if !a.Equal(vec{-1, 0}) || !b.Equal(vec{1, 0}) {
+		t.Errorf("expected velocities to be swapped, got %v %v", a, b)
+	}
+
+	// a static body reflects the velocity, using the positions as normal
+	a, b = vector.ResolveImpulse(vec{0, 0}, vec{2, 1}, 1, vec{1, 0}, vec{0, 0}, math.Inf(1), nil, 0.5)
+
+	if !a.Equal(vec{-1, 1}) || !b.Equal(vec{0, 0}) {
+		t.Errorf("expected bounce off static body, got %v %v", a, b)
+	}
+
+	// bodies moving apart are not changed
+	a, b = vector.ResolveImpulse(vec{0, 0}, vec{-1, 0}, 1, vec{1, 0}, vec{1, 0}, 1, vec{1, 0}, 1)
+
+	if !a.Equal(vec{-1, 0}) || !b.Equal(vec{1, 0}) {
+		t.Errorf("expected separating bodies to be unchanged, got %v %v", a, b)
+	}
+}
+
+func TestApplyDragFrameRateIndependent(t *testing.T) {
+	once := vector.ApplyDrag(vec{30, 40}, 0.5, 0.01, 1)
+	stepped := vec{30, 40}
+
+	for i := 0; i < 60; i++ {
+		stepped = vector.ApplyDrag(stepped, 0.5, 0.01, 1./60)
+	}
+
+	if !once.Equal(stepped) {
+		t.Errorf("expected the same result independent of time step, got %v %v", once, stepped)
+	}
+}
+
+func ExampleApplyDrag() {
+	fmt.Println(
+		vector.ApplyDrag(vec{10, 0}, 0, 0.1, 1),
+	)
+	// Output: [5 0]
+}
+
+func TestAngularVelocity(t *testing.T) {
+	if w := vector.AngularVelocity(1, 4, 2, 0.5); w != 2 {
+		t.Errorf("expected 2, got %v", w)
+	}
+
+	if w := vector.AngularVelocity(1, 4, 0, 0.5); w != 1 {
+		t.Errorf("expected a zero inertia to not change the angular velocity, got %v", w)
+	}
+}
+
+func ExampleTorque() {
+	fmt.Println(
+		vector.Torque(vec{2, 0}, vec{0, 3}),
+		vector.Torque(vec{2, 0}, vec{3, 0}),
+	)
+	// Output: 6 0
+}
+
+func ExamplePointVelocity() {
+	fmt.Println(
+		vector.PointVelocity(vec{1, 0}, 2, vec{0, 1}),
+	)
+	// Output: [-1 0]
+}
@@ -0,0 +1,69 @@
+package vector
+
+import (
+	"errors"
+	"math"
+)
+
+var (
+	// ErrCollinearPoints is returned when points that should span a plane
+	// or a triangle lies on a single line
+	ErrCollinearPoints = errors.New("points are collinear")
+)
+
+// Plane is an infinite plane described by a normal of length one and the
+// distance D, which satisfies Normal·p + D = 0 for all points p on the plane
+type Plane struct {
+	Normal Vector
+	D      float64
+}
+
+// NewPlane creates a plane with the given normal that goes through a point,
+// the normal is scaled to a length of one
+func NewPlane(normal, point Vector) Plane {
+	n := Unit(normal)
+	return Plane{n, -Dot(n, point)}
+}
+
+// NewPlaneFromPoints creates a plane through three 3-dimensional points, the
+// normal points toward the side where the points are seen counterclockwise
+func NewPlaneFromPoints(a, b, c Vector) (Plane, error) {
+	n, err := Cross(Sub(b, a), Sub(c, a))
+
+	if err != nil {
+		return Plane{}, err
+	}
+
+	if n.Magnitude() < 1e-8 {
+		return Plane{}, ErrCollinearPoints
+	}
+
+	return NewPlane(n, a), nil
+}
+
+// DistanceToPoint returns the signed distance from the plane to a point, it
+// is positive on the side the normal points to
+func (p Plane) DistanceToPoint(v Vector) float64 {
+	return Dot(v, p.Normal) + p.D
+}
+
+// ProjectPoint returns the point on the plane closest to v
+func (p Plane) ProjectPoint(v Vector) Vector {
+	return AddScaled(v, p.Normal, -p.DistanceToPoint(v))
+}
+
+// Side returns 1 if a point is on the side of the plane the normal points
+// to, -1 if it is on the other side and 0 if it is within 1e-8 of the plane
+func (p Plane) Side(v Vector) int {
+	d := p.DistanceToPoint(v)
+
+	if math.Abs(d) < 1e-8 {
+		return 0
+	}
+
+	if d > 0 {
+		return 1
+	}
+
+	return -1
+}
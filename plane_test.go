@@ -0,0 +1,54 @@
+package vector_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/kvartborg/vector"
+)
+
+func TestNewPlaneFromPoints(t *testing.T) {
+	p, err := vector.NewPlaneFromPoints(vec{0, 0, 2}, vec{1, 0, 2}, vec{0, 1, 2})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !p.Normal.Equal(vec{0, 0, 1}) || p.D != -2 {
+		t.Errorf("expected the plane z = 2, got %v", p)
+	}
+
+	if _, err := vector.NewPlaneFromPoints(vec{0, 0, 0}, vec{1, 1, 1}, vec{2, 2, 2}); err != vector.ErrCollinearPoints {
+		t.Errorf("expected ErrCollinearPoints, got %v", err)
+	}
+}
+
+func ExamplePlane_DistanceToPoint() {
+	p := vector.NewPlane(vec{0, 1, 0}, vec{0, 2, 0})
+
+	fmt.Println(
+		p.DistanceToPoint(vec{5, 5, 5}),
+		p.DistanceToPoint(vec{0, -1, 0}),
+	)
+	// Output: 3 -3
+}
+
+func ExamplePlane_ProjectPoint() {
+	p := vector.NewPlane(vec{0, 1, 0}, vec{0, 2, 0})
+
+	fmt.Println(
+		p.ProjectPoint(vec{5, 5, 5}),
+	)
+	// Output: [5 2 5]
+}
+
+func ExamplePlane_Side() {
+	p := vector.NewPlane(vec{0, 1, 0}, vec{0, 2, 0})
+
+	fmt.Println(
+		p.Side(vec{0, 3, 0}),
+		p.Side(vec{0, 1, 0}),
+		p.Side(vec{4, 2, 4}),
+	)
+	// Output: 1 -1 0
+}
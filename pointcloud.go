@@ -0,0 +1,367 @@
+package vector
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+)
+
+var (
+	// ErrInvalidPointCloud is returned when a point cloud file can not be
+	// parsed
+	ErrInvalidPointCloud = errors.New("invalid point cloud")
+)
+
+// PointDecoder reads the points of a point cloud one at a time, Next returns
+// io.EOF when there are no more points.
+type PointDecoder interface {
+	Next() (Vector, error)
+}
+
+// XYZDecoder decodes points from a text file where each line holds the
+// whitespace separated coordinates of a point. Blank lines and lines starting
+// with # are skipped, columns after the third are ignored.
+type XYZDecoder struct {
+	scanner *bufio.Scanner
+}
+
+// NewXYZDecoder creates a decoder that reads points from r
+func NewXYZDecoder(r io.Reader) *XYZDecoder {
+	return &XYZDecoder{bufio.NewScanner(r)}
+}
+
+// Next returns the next point of the file
+func (d *XYZDecoder) Next() (Vector, error) {
+	for d.scanner.Scan() {
+		fields := strings.Fields(d.scanner.Text())
+
+		if len(fields) == 0 || strings.HasPrefix(fields[0], "#") {
+			continue
+		}
+
+		if len(fields) > 3 {
+			fields = fields[:3]
+		}
+
+		v := make(Vector, len(fields))
+
+		for i := range fields {
+			scalar, err := strconv.ParseFloat(fields[i], 64)
+
+			if err != nil {
+				return nil, ErrInvalidPointCloud
+			}
+
+			v[i] = scalar
+		}
+
+		return v, nil
+	}
+
+	if err := d.scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return nil, io.EOF
+}
+
+// PLYDecoder decodes the vertex positions of a PLY file in ascii or binary
+// format, other elements like faces are skipped.
+type PLYDecoder struct {
+	r        *bufio.Reader
+	order    binary.ByteOrder
+	elements []plyElement
+	vertex   int
+	axis     []int
+	read     int
+	skipped  bool
+}
+
+type plyElement struct {
+	name       string
+	count      int
+	properties []plyProperty
+}
+
+type plyProperty struct {
+	name      string
+	kind      string
+	countKind string
+}
+
+// sizes of the scalar types supported by the PLY format
+var plySizes = map[string]int{
+	"char": 1, "int8": 1, "uchar": 1, "uint8": 1,
+	"short": 2, "int16": 2, "ushort": 2, "uint16": 2,
+	"int": 4, "int32": 4, "uint": 4, "uint32": 4,
+	"float": 4, "float32": 4, "double": 8, "float64": 8,
+}
+
+// NewPLYDecoder reads the header of a PLY file and creates a decoder that
+// reads the vertex positions from r. The dimension of the points depends on
+// which of the x, y and z properties the vertex element has.
+func NewPLYDecoder(r io.Reader) (*PLYDecoder, error) {
+	d := &PLYDecoder{r: bufio.NewReader(r), vertex: -1}
+
+	if line, err := d.readLine(); err != nil || line != "ply" {
+		return nil, ErrInvalidPointCloud
+	}
+
+	format := false
+
+	for {
+		line, err := d.readLine()
+
+		if err != nil {
+			return nil, ErrInvalidPointCloud
+		}
+
+		fields := strings.Fields(line)
+
+		if len(fields) == 0 {
+			continue
+		}
+
+		switch fields[0] {
+		case "format":
+			if len(fields) < 2 {
+				return nil, ErrInvalidPointCloud
+			}
+
+			format = true
+
+			switch fields[1] {
+			case "ascii":
+			case "binary_little_endian":
+				d.order = binary.LittleEndian
+			case "binary_big_endian":
+				d.order = binary.BigEndian
+			default:
+				return nil, ErrInvalidPointCloud
+			}
+		case "element":
+			if len(fields) != 3 {
+				return nil, ErrInvalidPointCloud
+			}
+
+			count, err := strconv.Atoi(fields[2])
+
+			if err != nil || count < 0 {
+				return nil, ErrInvalidPointCloud
+			}
+
+			if fields[1] == "vertex" {
+				d.vertex = len(d.elements)
+			}
+
+			d.elements = append(d.elements, plyElement{name: fields[1], count: count})
+		case "property":
+			if len(d.elements) == 0 {
+				return nil, ErrInvalidPointCloud
+			}
+
+			var p plyProperty
+
+			if len(fields) == 5 && fields[1] == "list" {
+				p = plyProperty{fields[4], fields[3], fields[2]}
+			} else if len(fields) == 3 {
+				p = plyProperty{name: fields[2], kind: fields[1]}
+			} else {
+				return nil, ErrInvalidPointCloud
+			}
+
+			if plySizes[p.kind] == 0 || (p.countKind != "" && plySizes[p.countKind] == 0) {
+				return nil, ErrInvalidPointCloud
+			}
+
+			e := &d.elements[len(d.elements)-1]
+			e.properties = append(e.properties, p)
+		case "end_header":
+			if !format {
+				return nil, ErrInvalidPointCloud
+			}
+
+			if err := d.init(); err != nil {
+				return nil, err
+			}
+
+			return d, nil
+		}
+	}
+}
+
+// init looks up the position properties of the vertex element
+func (d *PLYDecoder) init() error {
+	if d.vertex < 0 {
+		return ErrInvalidPointCloud
+	}
+
+	for _, name := range []string{"x", "y", "z"} {
+		index := -1
+
+		for i, p := range d.elements[d.vertex].properties {
+			if p.name == name && p.countKind == "" {
+				index = i
+			}
+		}
+
+		if index < 0 {
+			break
+		}
+
+		d.axis = append(d.axis, index)
+	}
+
+	if len(d.axis) == 0 {
+		return ErrInvalidPointCloud
+	}
+
+	return nil
+}
+
+// Next returns the position of the next vertex of the file
+func (d *PLYDecoder) Next() (Vector, error) {
+	if !d.skipped {
+		for _, e := range d.elements[:d.vertex] {
+			for i := 0; i < e.count; i++ {
+				if _, err := d.readElement(e); err != nil {
+					return nil, err
+				}
+			}
+		}
+
+		d.skipped = true
+	}
+
+	e := d.elements[d.vertex]
+
+	if d.read >= e.count {
+		return nil, io.EOF
+	}
+
+	values, err := d.readElement(e)
+
+	if err != nil {
+		return nil, err
+	}
+
+	d.read++
+	v := make(Vector, len(d.axis))
+
+	for i, index := range d.axis {
+		v[i] = values[index]
+	}
+
+	return v, nil
+}
+
+// readElement reads a single element, the value of list properties are
+// discarded and reported as 0.
+func (d *PLYDecoder) readElement(e plyElement) ([]float64, error) {
+	values := make([]float64, len(e.properties))
+
+	if d.order == nil {
+		line, err := d.readLine()
+
+		if err != nil {
+			return nil, ErrInvalidPointCloud
+		}
+
+		fields := strings.Fields(line)
+
+		for i, p := range e.properties {
+			if len(fields) == 0 {
+				return nil, ErrInvalidPointCloud
+			}
+
+			scalar, err := strconv.ParseFloat(fields[0], 64)
+
+			if err != nil {
+				return nil, ErrInvalidPointCloud
+			}
+
+			fields = fields[1:]
+
+			if p.countKind == "" {
+				values[i] = scalar
+			} else if int(scalar) < 0 || int(scalar) > len(fields) {
+				return nil, ErrInvalidPointCloud
+			} else {
+				fields = fields[int(scalar):]
+			}
+		}
+
+		return values, nil
+	}
+
+	for i, p := range e.properties {
+		if p.countKind == "" {
+			scalar, err := d.readBinary(p.kind)
+
+			if err != nil {
+				return nil, err
+			}
+
+			values[i] = scalar
+			continue
+		}
+
+		count, err := d.readBinary(p.countKind)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if count < 0 {
+			return nil, ErrInvalidPointCloud
+		}
+
+		if _, err := d.r.Discard(int(count) * plySizes[p.kind]); err != nil {
+			return nil, ErrInvalidPointCloud
+		}
+	}
+
+	return values, nil
+}
+
+func (d *PLYDecoder) readBinary(kind string) (float64, error) {
+	var buf [8]byte
+	b := buf[:plySizes[kind]]
+
+	if _, err := io.ReadFull(d.r, b); err != nil {
+		return 0, ErrInvalidPointCloud
+	}
+
+	switch kind {
+	case "char", "int8":
+		return float64(int8(b[0])), nil
+	case "uchar", "uint8":
+		return float64(b[0]), nil
+	case "short", "int16":
+		return float64(int16(d.order.Uint16(b))), nil
+	case "ushort", "uint16":
+		return float64(d.order.Uint16(b)), nil
+	case "int", "int32":
+		return float64(int32(d.order.Uint32(b))), nil
+	case "uint", "uint32":
+		return float64(d.order.Uint32(b)), nil
+	case "float", "float32":
+		return float64(math.Float32frombits(d.order.Uint32(b))), nil
+	default:
+		return math.Float64frombits(d.order.Uint64(b)), nil
+	}
+}
+
+func (d *PLYDecoder) readLine() (string, error) {
+	line, err := d.r.ReadString('\n')
+
+	if err != nil && (err != io.EOF || line == "") {
+		return "", err
+	}
+
+	return strings.TrimSpace(line), nil
+}
@@ -0,0 +1,126 @@
+package vector_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/kvartborg/vector"
+)
+
+func decodeAll(t *testing.T, d vector.PointDecoder) []vec {
+	points := []vec{}
+
+	for {
+		v, err := d.Next()
+
+		if err == io.EOF {
+			return points
+		}
+
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		points = append(points, v)
+	}
+}
+
+func TestXYZDecoder(t *testing.T) {
+	d := vector.NewXYZDecoder(strings.NewReader("# comment\n1 2 3\n\n4 5 6 255 0 0\n7 8"))
+	points := decodeAll(t, d)
+
+	if len(points) != 3 || !points[1].Equal(vec{4, 5, 6}) || !points[2].Equal(vec{7, 8}) {
+		t.Errorf("did not decode points correctly, got %v", points)
+	}
+
+	if _, err := vector.NewXYZDecoder(strings.NewReader("1 a 3")).Next(); err != vector.ErrInvalidPointCloud {
+		t.Error("expected invalid number to be rejected")
+	}
+}
+
+func TestPLYDecoderASCII(t *testing.T) {
+	ply := `ply
+format ascii 1.0
+comment made by hand
+element face 1
+property list uchar int vertex_indices
+element vertex 2
+property float x
+property float y
+property float z
+property uchar red
+end_header
+3 0 1 2
+1 2 3 255
+4 5 6 0
+`
+	d, err := vector.NewPLYDecoder(strings.NewReader(ply))
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	points := decodeAll(t, d)
+
+	if len(points) != 2 || !points[0].Equal(vec{1, 2, 3}) || !points[1].Equal(vec{4, 5, 6}) {
+		t.Errorf("did not decode points correctly, got %v", points)
+	}
+}
+
+func TestPLYDecoderBinary(t *testing.T) {
+	var buf bytes.Buffer
+	buf.WriteString("ply\nformat binary_big_endian 1.0\nelement vertex 2\nproperty double x\nproperty float y\nproperty list uchar short n\nend_header\n")
+
+	for _, p := range [][2]float64{{1, 2}, {3, 4}} {
+		binary.Write(&buf, binary.BigEndian, math.Float64bits(p[0]))
+		binary.Write(&buf, binary.BigEndian, math.Float32bits(float32(p[1])))
+		buf.Write([]byte{1, 0, 9})
+	}
+
+	d, err := vector.NewPLYDecoder(&buf)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	points := decodeAll(t, d)
+
+	if len(points) != 2 || !points[0].Equal(vec{1, 2}) || !points[1].Equal(vec{3, 4}) {
+		t.Errorf("did not decode points correctly, got %v", points)
+	}
+}
+
+func TestPLYDecoderInvalid(t *testing.T) {
+	for _, ply := range []string{
+		"obj\n",
+		"ply\nformat ascii 1.0\nelement face 0\nend_header\n",
+		"ply\nformat ascii 1.0\nelement vertex 1\nproperty quad x\nend_header\n",
+		"ply\nelement vertex 1\nproperty float x\nend_header\n",
+	} {
+		if d, err := vector.NewPLYDecoder(strings.NewReader(ply)); err != vector.ErrInvalidPointCloud || d != nil {
+			t.Errorf("expected %q to be rejected", ply)
+		}
+	}
+}
+
+func ExampleNewXYZDecoder() {
+	d := vector.NewXYZDecoder(strings.NewReader("1 2 3\n4 5 6\n"))
+
+	for {
+		v, err := d.Next()
+
+		if err != nil {
+			break
+		}
+
+		fmt.Println(v)
+	}
+	// Output:
+	// [1 2 3]
+	// [4 5 6]
+}
@@ -0,0 +1,170 @@
+package vector
+
+import "math"
+
+// Quaternion describes a rotation in 3 dimensions, it can be composed with
+// other rotations without gimbal lock and interpolated smoothly. Rotations
+// are described by quaternions with a length of one.
+type Quaternion struct {
+	W, X, Y, Z float64
+}
+
+// IdentityQuaternion returns the quaternion that does not rotate
+func IdentityQuaternion() Quaternion {
+	return Quaternion{W: 1}
+}
+
+// QuaternionFromAxisAngle returns a quaternion that rotates counterclockwise
+// by angle radians around an axis, the axis does not need to have a length
+// of one. A zero axis gives the identity quaternion.
+func QuaternionFromAxisAngle(axis Vector, angle float64) Quaternion {
+	k := Vector{axis.X(), axis.Y(), axis.Z()}
+
+	if k.Magnitude() < 1e-8 {
+		return IdentityQuaternion()
+	}
+
+	k.Unit()
+	sin, cos := math.Sincos(angle / 2)
+
+	return Quaternion{cos, k[X] * sin, k[Y] * sin, k[Z] * sin}
+}
+
+// QuaternionFromEuler returns a quaternion that rotates by the given angles
+// in radians around the x, y and z axis, in that order like
+// Matrix3FromEuler
+func QuaternionFromEuler(x, y, z float64) Quaternion {
+	qx := QuaternionFromAxisAngle(Vector{1, 0, 0}, x)
+	qy := QuaternionFromAxisAngle(Vector{0, 1, 0}, y)
+	qz := QuaternionFromAxisAngle(Vector{0, 0, 1}, z)
+
+	return qz.Mul(qy).Mul(qx)
+}
+
+// Mul multiplies the quaternion with another quaternion, the result rotates
+// by r before q
+func (q Quaternion) Mul(r Quaternion) Quaternion {
+	return Quaternion{
+		q.W*r.W - q.X*r.X - q.Y*r.Y - q.Z*r.Z,
+		q.W*r.X + q.X*r.W + q.Y*r.Z - q.Z*r.Y,
+		q.W*r.Y - q.X*r.Z + q.Y*r.W + q.Z*r.X,
+		q.W*r.Z + q.X*r.Y - q.Y*r.X + q.Z*r.W,
+	}
+}
+
+// Dot returns the dot product of two quaternions
+func (q Quaternion) Dot(r Quaternion) float64 {
+	return q.W*r.W + q.X*r.X + q.Y*r.Y + q.Z*r.Z
+}
+
+// Length returns the length of the quaternion
+func (q Quaternion) Length() float64 {
+	return math.Sqrt(q.Dot(q))
+}
+
+// Normalize returns the quaternion scaled to a length of one, a zero
+// quaternion is returned unchanged
+func (q Quaternion) Normalize() Quaternion {
+	l := q.Length()
+
+	if l < 1e-8 {
+		return q
+	}
+
+	return Quaternion{q.W / l, q.X / l, q.Y / l, q.Z / l}
+}
+
+// Conjugate returns the quaternion with the vector part negated, for a
+// quaternion with a length of one it is the inverse rotation
+func (q Quaternion) Conjugate() Quaternion {
+	return Quaternion{q.W, -q.X, -q.Y, -q.Z}
+}
+
+// Inverse returns the inverse of the quaternion, a zero quaternion has no
+// inverse and is returned unchanged
+func (q Quaternion) Inverse() Quaternion {
+	l := q.Dot(q)
+
+	if l < 1e-16 {
+		return q
+	}
+
+	c := q.Conjugate()
+	return Quaternion{c.W / l, c.X / l, c.Y / l, c.Z / l}
+}
+
+// Slerp interpolates between two rotations along the shortest arc with a
+// constant angular speed, t of 0 returns q and 1 returns r
+func (q Quaternion) Slerp(r Quaternion, t float64) Quaternion {
+	cos := q.Dot(r)
+
+	// q and -q describes the same rotation, flip r to take the shortest arc
+	if cos < 0 {
+		r, cos = Quaternion{-r.W, -r.X, -r.Y, -r.Z}, -cos
+	}
+
+	a, b := 1-t, t
+
+	// fall back to linear interpolation when the rotations are very close
+	if cos < 1-1e-6 {
+		angle := math.Acos(cos)
+		sin := math.Sin(angle)
+		a, b = math.Sin(a*angle)/sin, math.Sin(b*angle)/sin
+	}
+
+	return Quaternion{
+		a*q.W + b*r.W,
+		a*q.X + b*r.X,
+		a*q.Y + b*r.Y,
+		a*q.Z + b*r.Z,
+	}.Normalize()
+}
+
+// RotateVector returns a new 3-dimensional vector rotated by the quaternion,
+// the quaternion is normalized before it is used
+func (q Quaternion) RotateVector(v Vector) Vector {
+	q = q.Normalize()
+	u := Vector{q.X, q.Y, q.Z}
+	p := Vector{v.X(), v.Y(), v.Z()}
+
+	// v' = v + 2w(u x v) + 2u x (u x v)
+	t, _ := Cross(u, p)
+	t.Scale(2)
+	c, _ := Cross(u, t)
+
+	return p.AddScaled(t, q.W).Add(c)
+}
+
+// Matrix3 returns the rotation of the quaternion as a 3x3 matrix
+func (q Quaternion) Matrix3() Matrix3 {
+	q = q.Normalize()
+	w, x, y, z := q.W, q.X, q.Y, q.Z
+
+	return Matrix3{
+		1 - 2*(y*y+z*z), 2 * (x*y - w*z), 2 * (x*z + w*y),
+		2 * (x*y + w*z), 1 - 2*(x*x+z*z), 2 * (y*z - w*x),
+		2 * (x*z - w*y), 2 * (y*z + w*x), 1 - 2*(x*x+y*y),
+	}
+}
+
+// QuaternionFromMatrix3 returns the rotation of a 3x3 rotation matrix as a
+// quaternion
+func QuaternionFromMatrix3(m Matrix3) Quaternion {
+	trace := m[0] + m[4] + m[8]
+
+	// pick the largest of w, x, y and z to divide by, to stay accurate
+	switch {
+	case trace > 0:
+		s := 2 * math.Sqrt(trace+1)
+		return Quaternion{s / 4, (m[7] - m[5]) / s, (m[2] - m[6]) / s, (m[3] - m[1]) / s}
+	case m[0] > m[4] && m[0] > m[8]:
+		s := 2 * math.Sqrt(1+m[0]-m[4]-m[8])
+		return Quaternion{(m[7] - m[5]) / s, s / 4, (m[1] + m[3]) / s, (m[2] + m[6]) / s}
+	case m[4] > m[8]:
+		s := 2 * math.Sqrt(1+m[4]-m[0]-m[8])
+		return Quaternion{(m[2] - m[6]) / s, (m[1] + m[3]) / s, s / 4, (m[5] + m[7]) / s}
+	default:
+		s := 2 * math.Sqrt(1+m[8]-m[0]-m[4])
+		return Quaternion{(m[3] - m[1]) / s, (m[2] + m[6]) / s, (m[5] + m[7]) / s, s / 4}
+	}
+}
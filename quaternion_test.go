@@ -0,0 +1,71 @@
+package vector_test
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/kvartborg/vector"
+)
+
+func TestQuaternionMatchesMatrix3(t *testing.T) {
+	v := vec{1, 2, 3}
+	q := vector.QuaternionFromEuler(0.3, -0.5, 1.1)
+	expected := vector.Matrix3FromEuler(0.3, -0.5, 1.1).MulVec(v)
+
+	if result := q.RotateVector(v); !result.Equal(expected) {
+		t.Errorf("expected %v, got %v", expected, result)
+	}
+
+	if result := q.Matrix3().MulVec(v); !result.Equal(expected) {
+		t.Errorf("expected %v, got %v", expected, result)
+	}
+}
+
+func TestQuaternionInverse(t *testing.T) {
+	q := vector.QuaternionFromAxisAngle(vec{1, 2, 3}, 0.9)
+	v := vec{4, -1, 2}
+
+	if result := q.Inverse().RotateVector(q.RotateVector(v)); !result.Equal(v) {
+		t.Errorf("expected %v, got %v", v, result)
+	}
+
+	if id := q.Mul(q.Inverse()); math.Abs(id.W-1) > 1e-9 {
+		t.Errorf("expected the identity, got %v", id)
+	}
+}
+
+func TestQuaternionSlerp(t *testing.T) {
+	a := vector.IdentityQuaternion()
+	b := vector.QuaternionFromAxisAngle(vec{0, 0, 1}, math.Pi/2)
+
+	if v := a.Slerp(b, 0.5).RotateVector(vec{1, 0, 0}); !v.Equal(vec{math.Sqrt2 / 2, math.Sqrt2 / 2, 0}) {
+		t.Errorf("expected a 45 degree rotation halfway, got %v", v)
+	}
+
+	if v := a.Slerp(a, 0.5).RotateVector(vec{1, 0, 0}); !v.Equal(vec{1, 0, 0}) {
+		t.Errorf("expected no rotation between equal quaternions, got %v", v)
+	}
+}
+
+func ExampleQuaternion_RotateVector() {
+	q := vector.QuaternionFromAxisAngle(vec{0, 0, 1}, math.Pi/2)
+
+	fmt.Println(
+		q.RotateVector(vec{1, 0, 0}).Equal(vec{0, 1, 0}),
+	)
+	// Output: true
+}
+
+func TestQuaternionFromMatrix3(t *testing.T) {
+	for _, angle := range []float64{0.2, 2, 3.1} {
+		for _, axis := range []vec{{1, 0, 0}, {0, 1, 0}, {0, 0, 1}, {1, -2, 3}} {
+			m := vector.Matrix3FromAxisAngle(axis, angle)
+			v := vec{1, 2, 3}
+
+			if result := vector.QuaternionFromMatrix3(m).RotateVector(v); !result.Equal(m.MulVec(v)) {
+				t.Errorf("expected %v, got %v", m.MulVec(v), result)
+			}
+		}
+	}
+}
@@ -0,0 +1,62 @@
+package vector
+
+import "math"
+
+// Ray is a half-line that starts at an origin and extends infinitely in a
+// direction. The intersection methods returns the distance t along the ray
+// in units of the length of the direction, use At to find the point.
+type Ray struct {
+	Origin, Direction Vector
+}
+
+// At returns the point at t along the ray, Origin + Direction * t
+func (r Ray) At(t float64) Vector {
+	return AddScaled(r.Origin, r.Direction, t)
+}
+
+// IntersectPlane returns where the ray hits a plane, it reports false if the
+// ray is parallel to the plane or points away from it
+func (r Ray) IntersectPlane(p Plane) (float64, bool) {
+	denom := Dot(r.Direction, p.Normal)
+
+	if math.Abs(denom) < 1e-12 {
+		return 0, false
+	}
+
+	t := -p.DistanceToPoint(r.Origin) / denom
+
+	return t, t >= 0
+}
+
+// IntersectSphere returns where the ray first hits a sphere, if the origin
+// is inside the sphere the point where the ray leaves it is returned
+func (r Ray) IntersectSphere(center Vector, radius float64) (float64, bool) {
+	oc := Sub(r.Origin, center)
+	a := r.Direction.MagnitudeSquared()
+	b := Dot(oc, r.Direction)
+	c := oc.MagnitudeSquared() - radius*radius
+	disc := b*b - a*c
+
+	if a < 1e-16 || disc < 0 {
+		return 0, false
+	}
+
+	sqrt := math.Sqrt(disc)
+
+	if t := (-b - sqrt) / a; t >= 0 {
+		return t, true
+	}
+
+	if t := (-b + sqrt) / a; t >= 0 {
+		return t, true
+	}
+
+	return 0, false
+}
+
+// IntersectAABB returns where the ray first hits a box, if the origin is
+// inside the box 0 is returned
+func (r Ray) IntersectAABB(box AABB) (float64, bool) {
+	t, _, hit := clipAABB(r.Origin, r.Direction, box, 0, math.Inf(1))
+	return t, hit
+}
@@ -0,0 +1,69 @@
+package vector_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/kvartborg/vector"
+)
+
+func TestRayIntersectPlane(t *testing.T) {
+	ground := vector.NewPlane(vec{0, 1, 0}, vec{0, 0, 0})
+
+	if tt, ok := (vector.Ray{Origin: vec{0, 5, 0}, Direction: vec{1, -1, 0}}).IntersectPlane(ground); !ok || tt != 5 {
+		t.Errorf("expected a hit at 5, got %v %v", tt, ok)
+	}
+
+	if _, ok := (vector.Ray{Origin: vec{0, 5, 0}, Direction: vec{0, 1, 0}}).IntersectPlane(ground); ok {
+		t.Error("expected no hit when pointing away from the plane")
+	}
+
+	if _, ok := (vector.Ray{Origin: vec{0, 5, 0}, Direction: vec{1, 0, 0}}).IntersectPlane(ground); ok {
+		t.Error("expected no hit when parallel to the plane")
+	}
+}
+
+func TestRayIntersectSphere(t *testing.T) {
+	r := vector.Ray{Origin: vec{-5, 0, 0}, Direction: vec{1, 0, 0}}
+
+	if tt, ok := r.IntersectSphere(vec{0, 0, 0}, 1); !ok || tt != 4 {
+		t.Errorf("expected a hit at 4, got %v %v", tt, ok)
+	}
+
+	if tt, ok := r.IntersectSphere(vec{-5, 0, 0}, 1); !ok || tt != 1 {
+		t.Errorf("expected a hit at 1 from inside, got %v %v", tt, ok)
+	}
+
+	if _, ok := r.IntersectSphere(vec{0, 2, 0}, 1); ok {
+		t.Error("expected a miss")
+	}
+
+	if _, ok := r.IntersectSphere(vec{-10, 0, 0}, 1); ok {
+		t.Error("expected no hit behind the ray")
+	}
+}
+
+func TestRayIntersectAABB(t *testing.T) {
+	box := vector.AABB{Min: vec{1, 1}, Max: vec{3, 3}}
+
+	if tt, ok := (vector.Ray{Origin: vec{0, 0}, Direction: vec{1, 1}}).IntersectAABB(box); !ok || tt != 1 {
+		t.Errorf("expected a hit at 1, got %v %v", tt, ok)
+	}
+
+	if tt, ok := (vector.Ray{Origin: vec{2, 2}, Direction: vec{1, 0}}).IntersectAABB(box); !ok || tt != 0 {
+		t.Errorf("expected a hit at 0 from inside, got %v %v", tt, ok)
+	}
+
+	if _, ok := (vector.Ray{Origin: vec{0, 0}, Direction: vec{-1, 0}}).IntersectAABB(box); ok {
+		t.Error("expected a miss")
+	}
+}
+
+func ExampleRay_At() {
+	r := vector.Ray{Origin: vec{1, 1}, Direction: vec{2, 0}}
+
+	fmt.Println(
+		r.At(1.5),
+	)
+	// Output: [4 1]
+}
@@ -0,0 +1,48 @@
+package vector
+
+import "math"
+
+// Segment is a line segment between the points A and B
+type Segment struct {
+	A, B Vector
+}
+
+// ClosestPoint returns the point on the segment closest to v
+func (s Segment) ClosestPoint(v Vector) Vector {
+	d := Sub(s.B, s.A)
+	l := d.MagnitudeSquared()
+
+	if l < 1e-16 {
+		return s.A.Clone()
+	}
+
+	t := Dot(Sub(v, s.A), d) / l
+	t = math.Max(0, math.Min(1, t))
+
+	return AddScaled(s.A, d, t)
+}
+
+// DistanceToPoint returns the distance from the segment to a point
+func (s Segment) DistanceToPoint(v Vector) float64 {
+	return Distance(s.ClosestPoint(v), v)
+}
+
+// Intersect returns the point where two 2-dimensional segments cross, it
+// reports false if they do not cross or are parallel
+func (s Segment) Intersect(other Segment) (Vector, bool) {
+	d1, d2 := Sub(s.B, s.A), Sub(other.B, other.A)
+	denom := d1.Cross2D(d2)
+
+	if math.Abs(denom) < 1e-12 {
+		return nil, false
+	}
+
+	ab := Sub(other.A, s.A)
+	t, u := ab.Cross2D(d2)/denom, ab.Cross2D(d1)/denom
+
+	if t < 0 || t > 1 || u < 0 || u > 1 {
+		return nil, false
+	}
+
+	return Vector{s.A.X() + d1.X()*t, s.A.Y() + d1.Y()*t}, true
+}
@@ -0,0 +1,52 @@
+package vector_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/kvartborg/vector"
+)
+
+func TestSegmentClosestPoint(t *testing.T) {
+	s := vector.Segment{A: vec{0, 0}, B: vec{4, 0}}
+
+	for _, c := range [][2]vec{
+		{{2, 3}, {2, 0}},
+		{{-2, 1}, {0, 0}},
+		{{9, -1}, {4, 0}},
+	} {
+		if p := s.ClosestPoint(c[0]); !p.Equal(c[1]) {
+			t.Errorf("expected the closest point to %v to be %v, got %v", c[0], c[1], p)
+		}
+	}
+
+	if p := (vector.Segment{A: vec{1, 1}, B: vec{1, 1}}).ClosestPoint(vec{5, 5}); !p.Equal(vec{1, 1}) {
+		t.Errorf("expected a degenerate segment to return its point, got %v", p)
+	}
+}
+
+func TestSegmentIntersect(t *testing.T) {
+	s := vector.Segment{A: vec{0, 0}, B: vec{4, 4}}
+
+	if p, ok := s.Intersect(vector.Segment{A: vec{0, 4}, B: vec{4, 0}}); !ok || !p.Equal(vec{2, 2}) {
+		t.Errorf("expected the segments to cross at [2 2], got %v %v", p, ok)
+	}
+
+	if _, ok := s.Intersect(vector.Segment{A: vec{0, 4}, B: vec{1, 3}}); ok {
+		t.Error("expected segments that do not reach each other to not cross")
+	}
+
+	if _, ok := s.Intersect(vector.Segment{A: vec{0, 1}, B: vec{4, 5}}); ok {
+		t.Error("expected parallel segments to not cross")
+	}
+}
+
+func ExampleSegment_DistanceToPoint() {
+	s := vector.Segment{A: vec{0, 0}, B: vec{4, 0}}
+
+	fmt.Println(
+		s.DistanceToPoint(vec{2, 3}),
+		s.DistanceToPoint(vec{7, 4}),
+	)
+	// Output: 3 5
+}
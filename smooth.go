@@ -0,0 +1,71 @@
+package vector
+
+// SmoothSeries returns a copy of a series of vectors smoothed with a
+// trailing moving average, each vector is the average of itself and up to
+// window - 1 vectors before it
+func SmoothSeries(vs []Vector, window int) []Vector {
+	if window < 1 {
+		window = 1
+	}
+
+	result := make([]Vector, len(vs))
+
+	for i := range vs {
+		start := i - window + 1
+
+		if start < 0 {
+			start = 0
+		}
+
+		sum := vs[i].Clone()
+
+		for _, v := range vs[start:i] {
+			sum.Add(v)
+		}
+
+		result[i] = sum.Scale(1 / float64(i-start+1))
+	}
+
+	return result
+}
+
+// ExponentialSmoother smooths a stream of vectors with an exponential moving
+// average, recent vectors weigh more than older vectors
+type ExponentialSmoother struct {
+	// Alpha is the weight of a new vector in the range (0, 1], lower values
+	// gives a smoother but slower response
+	Alpha float64
+
+	value Vector
+}
+
+// NewExponentialSmoother creates a smoother with the given alpha
+func NewExponentialSmoother(alpha float64) *ExponentialSmoother {
+	return &ExponentialSmoother{Alpha: alpha}
+}
+
+// Push adds a vector to the stream and returns the new smoothed value, the
+// first vector pushed is used as is
+func (s *ExponentialSmoother) Push(v Vector) Vector {
+	if s.value == nil {
+		s.value = v.Clone()
+	} else {
+		s.value = AddScaled(s.value, Sub(v, s.value), s.Alpha)
+	}
+
+	return s.value.Clone()
+}
+
+// Value returns the current smoothed value, nil if nothing has been pushed
+func (s *ExponentialSmoother) Value() Vector {
+	if s.value == nil {
+		return nil
+	}
+
+	return s.value.Clone()
+}
+
+// Reset clears the smoothed value, the next vector pushed is used as is
+func (s *ExponentialSmoother) Reset() {
+	s.value = nil
+}
@@ -0,0 +1,41 @@
+package vector_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/kvartborg/vector"
+)
+
+func TestExponentialSmootherReset(t *testing.T) {
+	s := vector.NewExponentialSmoother(0.5)
+
+	if s.Value() != nil {
+		t.Error("expected no value before anything is pushed")
+	}
+
+	s.Push(vec{4, 4})
+	s.Reset()
+
+	if v := s.Push(vec{1, 1}); !v.Equal(vec{1, 1}) {
+		t.Errorf("expected the first vector after a reset to be used as is, got %v", v)
+	}
+}
+
+func ExampleSmoothSeries() {
+	fmt.Println(
+		vector.SmoothSeries([]vec{{0, 3}, {2, 3}, {4, 0}, {6, 0}}, 2),
+	)
+	// Output: [[0 3] [1 3] [3 1.5] [5 0]]
+}
+
+func ExampleExponentialSmoother_Push() {
+	s := vector.NewExponentialSmoother(0.5)
+
+	fmt.Println(
+		s.Push(vec{0, 0}),
+		s.Push(vec{4, 8}),
+		s.Push(vec{4, 8}),
+	)
+	// Output: [0 0] [2 4] [3 6]
+}
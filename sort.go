@@ -0,0 +1,56 @@
+package vector
+
+import "sort"
+
+// SortBy sorts a slice of vectors in place in ascending order of the key,
+// the key is only calculated once per vector and vectors with equal keys
+// keeps their order
+func SortBy(vs []Vector, key func(Vector) float64) {
+	s := byKey{vs, make([]float64, len(vs))}
+
+	for i, v := range vs {
+		s.keys[i] = key(v)
+	}
+
+	sort.Stable(s)
+}
+
+// SortByDistance sorts a slice of vectors in place by their distance to an
+// origin, closest first
+func SortByDistance(vs []Vector, origin Vector) {
+	SortBy(vs, func(v Vector) float64 {
+		d := Sub(v, origin)
+		return Dot(d, d)
+	})
+}
+
+// SortLexicographic sorts a slice of vectors in place by comparing their
+// components in order, a vector that is a prefix of another vector comes
+// first
+func SortLexicographic(vs []Vector) {
+	sort.SliceStable(vs, func(i, j int) bool {
+		a, b := vs[i], vs[j]
+
+		for k := 0; k < len(a) && k < len(b); k++ {
+			if a[k] != b[k] {
+				return a[k] < b[k]
+			}
+		}
+
+		return len(a) < len(b)
+	})
+}
+
+// byKey sorts vectors by precalculated keys
+type byKey struct {
+	vs   []Vector
+	keys []float64
+}
+
+func (s byKey) Len() int           { return len(s.vs) }
+func (s byKey) Less(i, j int) bool { return s.keys[i] < s.keys[j] }
+
+func (s byKey) Swap(i, j int) {
+	s.vs[i], s.vs[j] = s.vs[j], s.vs[i]
+	s.keys[i], s.keys[j] = s.keys[j], s.keys[i]
+}
@@ -0,0 +1,37 @@
+package vector_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/kvartborg/vector"
+)
+
+func TestSortByIsStable(t *testing.T) {
+	vs := []vec{{1, 0}, {0, 2}, {0, 1}, {2, 0}}
+	vector.SortBy(vs, func(v vec) float64 { return v.X() })
+
+	expected := []vec{{0, 2}, {0, 1}, {1, 0}, {2, 0}}
+
+	for i := range vs {
+		if !vs[i].Equal(expected[i]) {
+			t.Fatalf("expected %v, got %v", expected, vs)
+		}
+	}
+}
+
+func ExampleSortByDistance() {
+	vs := []vec{{5, 5}, {1, 0}, {-3, 0}}
+	vector.SortByDistance(vs, vec{0, 0})
+
+	fmt.Println(vs)
+	// Output: [[1 0] [-3 0] [5 5]]
+}
+
+func ExampleSortLexicographic() {
+	vs := []vec{{2, 1}, {1, 3}, {1, 2, 0}, {1, 2}}
+	vector.SortLexicographic(vs)
+
+	fmt.Println(vs)
+	// Output: [[1 2] [1 2 0] [1 3] [2 1]]
+}
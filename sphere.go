@@ -0,0 +1,64 @@
+package vector
+
+// Sphere is a ball described by a center and a radius, in 2 dimensions it
+// is a circle
+type Sphere struct {
+	Center Vector
+	Radius float64
+}
+
+// NewSphereFromPoints returns a sphere that contains a set of points using
+// Ritter's algorithm, the sphere is at most a few percent larger than the
+// smallest possible sphere. See Bounds for the errors returned.
+func NewSphereFromPoints(vs []Vector) (Sphere, error) {
+	if err := checkDimensions(vs); err != nil {
+		return Sphere{}, err
+	}
+
+	a := farthest(vs[0], vs)
+	b := farthest(a, vs)
+	s := Sphere{Add(a, b).Scale(0.5), Distance(a, b) / 2}
+
+	for _, v := range vs {
+		d := Distance(s.Center, v)
+
+		if d <= s.Radius {
+			continue
+		}
+
+		r := (s.Radius + d) / 2
+		s.Center.AddScaled(Sub(v, s.Center), (r-s.Radius)/d)
+		s.Radius = r
+	}
+
+	return s, nil
+}
+
+// farthest returns the point of a set that is farthest from v
+func farthest(v Vector, vs []Vector) Vector {
+	result, max := vs[0], -1.
+
+	for _, p := range vs {
+		if d := DistanceSquared(v, p); d > max {
+			result, max = p, d
+		}
+	}
+
+	return result
+}
+
+// Contains reports if a point is inside the sphere or on its surface
+func (s Sphere) Contains(v Vector) bool {
+	return DistanceSquared(s.Center, v) <= s.Radius*s.Radius
+}
+
+// Intersects reports if two spheres overlap or touch
+func (s Sphere) Intersects(other Sphere) bool {
+	r := s.Radius + other.Radius
+	return DistanceSquared(s.Center, other.Center) <= r*r
+}
+
+// IntersectsAABB reports if the sphere overlaps or touches a box
+func (s Sphere) IntersectsAABB(box AABB) bool {
+	return s.Contains(Clamp(s.Center, box.Min, box.Max))
+}
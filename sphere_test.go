@@ -0,0 +1,67 @@
+package vector_test
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+
+	"github.com/kvartborg/vector"
+)
+
+func TestNewSphereFromPoints(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	vs := make([]vec, 200)
+
+	for i := range vs {
+		vs[i] = vec{r.Float64()*10 - 5, r.Float64()*4 - 2, r.Float64()}
+	}
+
+	s, err := vector.NewSphereFromPoints(vs)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, v := range vs {
+		if vector.Distance(s.Center, v) > s.Radius+1e-9 {
+			t.Fatalf("expected %v to be inside the sphere %v", v, s)
+		}
+	}
+
+	if s.Radius > 6 {
+		t.Errorf("expected a tight sphere, got a radius of %v", s.Radius)
+	}
+}
+
+func TestSphereIntersects(t *testing.T) {
+	s := vector.Sphere{Center: vec{0, 0}, Radius: 1}
+
+	if !s.Intersects(vector.Sphere{Center: vec{2, 0}, Radius: 1}) || s.Intersects(vector.Sphere{Center: vec{3, 0}, Radius: 1}) {
+		t.Error("unexpected sphere intersection result")
+	}
+
+	if !s.IntersectsAABB(vector.AABB{Min: vec{0.5, 0.5}, Max: vec{2, 2}}) {
+		t.Error("expected the sphere to intersect the box")
+	}
+
+	if s.IntersectsAABB(vector.AABB{Min: vec{0.8, 0.8}, Max: vec{2, 2}}) {
+		t.Error("expected the sphere to miss the corner of the box")
+	}
+}
+
+func ExampleSphere_Contains() {
+	s := vector.Sphere{Center: vec{1, 1, 1}, Radius: 2}
+
+	fmt.Println(
+		s.Contains(vec{1, 2, 1}),
+		s.Contains(vec{3, 3, 1}),
+	)
+	// Output: true false
+}
+
+func ExampleNewSphereFromPoints() {
+	s, _ := vector.NewSphereFromPoints([]vec{{-2, 0}, {2, 0}, {0, 1}})
+
+	fmt.Println(s.Center, s.Radius)
+	// Output: [0 0] 2
+}
@@ -0,0 +1,253 @@
+package vector
+
+import (
+	"errors"
+	"math"
+)
+
+var (
+	// ErrNoVectors is returned in functions that operates on a set of vectors
+	// but received an empty set
+	ErrNoVectors = errors.New("no vectors given")
+
+	// ErrDimensionMismatch is returned in functions that expects a set of
+	// vectors to have the same dimension
+	ErrDimensionMismatch = errors.New("vectors does not have the same dimension")
+
+	// ErrZeroWeight is returned when the weights of a weighted average sums
+	// to zero
+	ErrZeroWeight = errors.New("weights sums to zero")
+)
+
+// Centroid returns the mean position of a set of vectors, all vectors must
+// have the same dimension
+func Centroid(vs []Vector) (Vector, error) {
+	if err := checkDimensions(vs); err != nil {
+		return nil, err
+	}
+
+	sum := make(Vector, len(vs[0]))
+
+	for _, v := range vs {
+		sum.Add(v)
+	}
+
+	return sum.Scale(1 / float64(len(vs))), nil
+}
+
+// WeightedAverage returns the average of a set of vectors where each vector
+// contributes by its weight, the weight of a vector is at the same index as
+// the vector
+func WeightedAverage(vs []Vector, weights []float64) (Vector, error) {
+	if err := checkDimensions(vs); err != nil {
+		return nil, err
+	}
+
+	if len(vs) != len(weights) {
+		return nil, ErrLengthMismatch
+	}
+
+	sum, total := make(Vector, len(vs[0])), 0.
+
+	for i, v := range vs {
+		axpyUnitaryTo(sum, weights[i], v, sum)
+		total += weights[i]
+	}
+
+	if total == 0 {
+		return nil, ErrZeroWeight
+	}
+
+	return sum.Scale(1 / total), nil
+}
+
+// Variance returns the population variance of each axis of a set of vectors
+func Variance(vs []Vector) (Vector, error) {
+	mean, err := Centroid(vs)
+
+	if err != nil {
+		return nil, err
+	}
+
+	variance := make(Vector, len(mean))
+
+	for _, v := range vs {
+		for i := range v {
+			d := v[i] - mean[i]
+			variance[i] += d * d
+		}
+	}
+
+	return variance.Scale(1 / float64(len(vs))), nil
+}
+
+// StdDev returns the population standard deviation of each axis of a set of
+// vectors
+func StdDev(vs []Vector) (Vector, error) {
+	variance, err := Variance(vs)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range variance {
+		variance[i] = math.Sqrt(variance[i])
+	}
+
+	return variance, nil
+}
+
+// Bounds returns the smallest axis-aligned bounding box that contains a set
+// of vectors
+func Bounds(vs []Vector) (AABB, error) {
+	if err := checkDimensions(vs); err != nil {
+		return AABB{}, err
+	}
+
+	min, max := vs[0].Clone(), vs[0].Clone()
+
+	for _, v := range vs[1:] {
+		min.Min(v)
+		max.Max(v)
+	}
+
+	return AABB{min, max}, nil
+}
+
+// NormalizePoints returns a copy of a set of vectors that is centered around
+// the origin and uniformly scaled to fit in a cube from -0.5 to 0.5, the
+// returned transform maps the normalized vectors back to the originals. If
+// the vectors are empty or does not have the same dimension nil and the
+// identity transform is returned.
+func NormalizePoints(vs []Vector) ([]Vector, Transform) {
+	box, err := Bounds(vs)
+
+	if err != nil {
+		return nil, Transform{}
+	}
+
+	center := Add(box.Min, box.Max).Scale(0.5)
+	size := 0.
+
+	for i := range box.Min {
+		size = math.Max(size, box.Max[i]-box.Min[i])
+	}
+
+	if size == 0 {
+		size = 1
+	}
+
+	t := Transform{Position: center, Scale: make(Vector, len(center))}
+
+	for i := range t.Scale {
+		t.Scale[i] = size
+	}
+
+	inv := t.Inverse()
+	normalized := make([]Vector, len(vs))
+
+	for i, v := range vs {
+		normalized[i] = inv.Apply(v)
+	}
+
+	return normalized, t
+}
+
+// GeometricMedian returns the point with the smallest sum of distances to a
+// set of vectors, it is less affected by outliers than the centroid. The
+// median is found with Weiszfeld's algorithm. If the vectors are empty or
+// does not have the same dimension nil is returned.
+func GeometricMedian(vs []Vector) Vector {
+	median, err := Centroid(vs)
+
+	if err != nil {
+		return nil
+	}
+
+	for n := 0; n < 200; n++ {
+		next, total := make(Vector, len(median)), 0.
+
+		for _, v := range vs {
+			d := Sub(v, median).Magnitude()
+
+			// points at the current estimate are skipped to avoid dividing by 0
+			if d < 1e-12 {
+				continue
+			}
+
+			axpyUnitaryTo(next, 1/d, v, next)
+			total += 1 / d
+		}
+
+		if total == 0 {
+			break
+		}
+
+		next.Scale(1 / total)
+		moved := Sub(next, median).Magnitude()
+		median = next
+
+		if moved < 1e-10 {
+			break
+		}
+	}
+
+	return median
+}
+
+// DominantDirection returns the mean direction of a set of direction
+// vectors as a unit vector, every direction counts equally regardless of its
+// length. A zero vector is returned when the directions cancel out.
+func DominantDirection(vs []Vector) Vector {
+	return meanResultant(vs).Unit()
+}
+
+// CircularVariance returns how spread out a set of direction vectors are, in
+// the range 0 when all directions are the same to 1 when they cancel out
+func CircularVariance(vs []Vector) float64 {
+	return 1 - meanResultant(vs).Magnitude()
+}
+
+// meanResultant returns the mean of the unit vectors of a set of directions,
+// zero vectors are ignored
+func meanResultant(vs []Vector) Vector {
+	var sum Vector
+	count := 0
+
+	for _, v := range vs {
+		l := v.Magnitude()
+
+		if l < 1e-12 {
+			continue
+		}
+
+		if len(v) > len(sum) {
+			sum = append(sum, make(Vector, len(v)-len(sum))...)
+		}
+
+		axpyUnitaryTo(sum[:len(v)], 1/l, v, sum[:len(v)])
+		count++
+	}
+
+	if count == 0 {
+		return Vector{}
+	}
+
+	return sum.Scale(1 / float64(count))
+}
+
+// checkDimensions returns an error if a set of vectors is empty or the
+// vectors does not have the same dimension
+func checkDimensions(vs []Vector) error {
+	if len(vs) == 0 {
+		return ErrNoVectors
+	}
+
+	for _, v := range vs[1:] {
+		if len(v) != len(vs[0]) {
+			return ErrDimensionMismatch
+		}
+	}
+
+	return nil
+}
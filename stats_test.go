@@ -0,0 +1,122 @@
+package vector_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/kvartborg/vector"
+)
+
+func TestCentroidErrors(t *testing.T) {
+	if _, err := vector.Centroid(nil); err != vector.ErrNoVectors {
+		t.Errorf("expected ErrNoVectors, got %v", err)
+	}
+
+	if _, err := vector.Centroid([]vec{{1, 2}, {1, 2, 3}}); err != vector.ErrDimensionMismatch {
+		t.Errorf("expected ErrDimensionMismatch, got %v", err)
+	}
+}
+
+func ExampleCentroid() {
+	fmt.Println(
+		vector.Centroid([]vec{{0, 0}, {4, 0}, {2, 6}}),
+	)
+	// Output: [2 2] <nil>
+}
+
+func TestWeightedAverageErrors(t *testing.T) {
+	if _, err := vector.WeightedAverage([]vec{{1}, {2}}, []float64{1}); err != vector.ErrLengthMismatch {
+		t.Errorf("expected ErrLengthMismatch, got %v", err)
+	}
+
+	if _, err := vector.WeightedAverage([]vec{{1}, {2}}, []float64{1, -1}); err != vector.ErrZeroWeight {
+		t.Errorf("expected ErrZeroWeight, got %v", err)
+	}
+}
+
+func ExampleWeightedAverage() {
+	fmt.Println(
+		vector.WeightedAverage([]vec{{0, 0}, {4, 8}}, []float64{3, 1}),
+	)
+	// Output: [1 2] <nil>
+}
+
+func ExampleVariance() {
+	fmt.Println(
+		vector.Variance([]vec{{1, 5}, {3, 5}, {5, 5}, {7, 5}}),
+	)
+	// Output: [5 0] <nil>
+}
+
+func ExampleStdDev() {
+	fmt.Println(
+		vector.StdDev([]vec{{2, 1}, {4, 1}, {4, 1}, {4, 1}, {5, 1}, {5, 1}, {7, 1}, {9, 1}}),
+	)
+	// Output: [2 0] <nil>
+}
+
+func ExampleBounds() {
+	box, _ := vector.Bounds([]vec{{1, 5}, {-2, 3}, {4, -1}})
+
+	fmt.Println(box.Min, box.Max)
+	// Output: [-2 -1] [4 5]
+}
+
+func TestNormalizePoints(t *testing.T) {
+	vs := []vec{{10, 20}, {14, 20}, {12, 21}}
+	normalized, tr := vector.NormalizePoints(vs)
+
+	for i, v := range normalized {
+		if !tr.Apply(v).Equal(vs[i]) {
+			t.Errorf("expected the transform to restore %v, got %v", vs[i], tr.Apply(v))
+		}
+	}
+}
+
+func ExampleNormalizePoints() {
+	normalized, _ := vector.NormalizePoints([]vec{{10, 20}, {14, 20}, {12, 22}})
+
+	fmt.Println(normalized)
+	// Output: [[-0.5 -0.25] [0.5 -0.25] [0 0.25]]
+}
+
+func TestGeometricMedianIgnoresOutliers(t *testing.T) {
+	vs := []vec{{0, 0}, {1, 0}, {0, 1}, {1, 1}, {100, 100}}
+	median := vector.GeometricMedian(vs)
+
+	if median.X() > 1 || median.Y() > 1 {
+		t.Errorf("expected the median to stay near the cluster, got %v", median)
+	}
+
+	if vector.GeometricMedian(nil) != nil {
+		t.Error("expected nil for an empty set")
+	}
+}
+
+func ExampleGeometricMedian() {
+	median := vector.GeometricMedian([]vec{{-1, 0}, {1, 0}, {0, -1}, {0, 1}, {0, 0}})
+
+	fmt.Println(median)
+	// Output: [0 0]
+}
+
+func TestCircularVariance(t *testing.T) {
+	if v := vector.CircularVariance([]vec{{1, 0}, {5, 0}}); v != 0 {
+		t.Errorf("expected no variance for equal directions, got %v", v)
+	}
+
+	if v := vector.CircularVariance([]vec{{1, 0}, {-2, 0}}); v != 1 {
+		t.Errorf("expected a variance of 1 for opposite directions, got %v", v)
+	}
+
+	if v := vector.CircularVariance(nil); v != 1 {
+		t.Errorf("expected a variance of 1 without directions, got %v", v)
+	}
+}
+
+func ExampleDominantDirection() {
+	fmt.Println(
+		vector.DominantDirection([]vec{{3, 0}, {0, 1}, {1, 0}, {0, -5}}),
+	)
+	// Output: [1 0]
+}
@@ -0,0 +1,206 @@
+package vector
+
+import "math"
+
+// Agent describes the movement of an autonomous agent, it is used to
+// calculate steering forces. The steering methods returns an acceleration
+// that should be added to the velocity of the agent.
+type Agent struct {
+	Position, Velocity Vector
+	MaxSpeed, MaxForce float64
+}
+
+// Seek returns the steering force that moves the agent towards a target at
+// full speed
+func (a Agent) Seek(target Vector) Vector {
+	desired := Sub(target, a.Position)
+	return a.steer(desired.Unit().Scale(a.MaxSpeed))
+}
+
+// Flee returns the steering force that moves the agent away from a target at
+// full speed
+func (a Agent) Flee(target Vector) Vector {
+	desired := Sub(a.Position, target)
+	return a.steer(desired.Unit().Scale(a.MaxSpeed))
+}
+
+// Arrive returns the steering force that moves the agent towards a target,
+// the agent slows down when it is within the slowing radius of the target so
+// it comes to a stop at the target
+func (a Agent) Arrive(target Vector, slowingRadius float64) Vector {
+	desired := Sub(target, a.Position)
+	distance := desired.Magnitude()
+	speed := a.MaxSpeed
+
+	if distance < slowingRadius {
+		speed *= distance / slowingRadius
+	}
+
+	return a.steer(desired.Unit().Scale(speed))
+}
+
+// Wander returns a steering force that makes a 2-dimensional agent move
+// around randomly but smoothly. A target is placed on a circle with the given
+// radius in front of the agent, angle is the position of the target on the
+// circle relative to the heading of the agent. The caller should change the
+// angle by a small random amount each update.
+func (a Agent) Wander(distance, radius, angle float64) Vector {
+	heading := math.Atan2(a.Velocity.Y(), a.Velocity.X())
+	center := Unit(Vector{a.Velocity.X(), a.Velocity.Y()}).Scale(distance)
+	target := center.Add(FromPolar(radius, heading+angle))
+
+	return a.Seek(target.Add(a.Position))
+}
+
+// steer turns a desired velocity into a steering force limited by the max
+// force of the agent
+func (a Agent) steer(desired Vector) Vector {
+	force := desired.Sub(a.Velocity)
+
+	if l := force.Magnitude(); l > a.MaxForce {
+		force.Scale(a.MaxForce / l)
+	}
+
+	return force
+}
+
+// Separation returns the steering force that keeps the agent away from the
+// positions of its neighbors, closer neighbors push harder. Neighbors
+// farther away than the radius and neighbors at the exact position of the
+// agent, like the agent itself, are ignored.
+func (a Agent) Separation(neighbors []Vector, radius float64) Vector {
+	sum := make(Vector, len(a.Position))
+
+	for _, n := range neighbors {
+		away := Sub(a.Position, n)
+		d := away.Magnitude()
+
+		if d < 1e-8 || d > radius {
+			continue
+		}
+
+		sum.Add(away.Scale((1 - d/radius) / d))
+	}
+
+	if sum.Magnitude() < 1e-8 {
+		return make(Vector, len(a.Position))
+	}
+
+	return a.steer(sum.Unit().Scale(a.MaxSpeed))
+}
+
+// Alignment returns the steering force that matches the velocity of the
+// agent with the average velocity of its neighbors within the radius,
+// closer neighbors are weighted higher. The velocity of a neighbor is at the
+// same index as its position, ErrLengthMismatch is returned if there is not
+// a velocity for every position.
+func (a Agent) Alignment(positions, velocities []Vector, radius float64) (Vector, error) {
+	if len(positions) != len(velocities) {
+		return nil, ErrLengthMismatch
+	}
+
+	sum := make(Vector, len(a.Velocity))
+
+	for i, n := range positions {
+		if d := Sub(a.Position, n).Magnitude(); d >= 1e-8 && d <= radius {
+			sum.Add(Scale(velocities[i], 1-d/radius))
+		}
+	}
+
+	if sum.Magnitude() < 1e-8 {
+		return make(Vector, len(a.Velocity)), nil
+	}
+
+	return a.steer(sum.Unit().Scale(a.MaxSpeed)), nil
+}
+
+// Cohesion returns the steering force that moves the agent towards the
+// center of its neighbors within the radius, closer neighbors are weighted
+// higher
+func (a Agent) Cohesion(neighbors []Vector, radius float64) Vector {
+	center, total := make(Vector, len(a.Position)), 0.
+
+	for _, n := range neighbors {
+		if d := Sub(a.Position, n).Magnitude(); d >= 1e-8 && d <= radius {
+			w := 1 - d/radius
+			center.Add(Scale(n, w))
+			total += w
+		}
+	}
+
+	if total < 1e-8 {
+		return make(Vector, len(a.Position))
+	}
+
+	return a.Seek(center.Scale(1 / total))
+}
+
+// InCone reports if a target is visible to an observer, the target must be
+// within the max distance and within half angle radians of the facing
+// direction. If any occluders are given, the line of sight between the
+// observer and the target must not pass through any of them.
+func InCone(observerPos, facing, targetPos Vector, halfAngle, maxDistance float64, occluders ...AABB) bool {
+	d := Sub(targetPos, observerPos)
+	distance := d.Magnitude()
+
+	if distance > maxDistance {
+		return false
+	}
+
+	if distance > 1e-8 {
+		f := facing.Magnitude()
+
+		if f < 1e-8 || Dot(d, facing)/(distance*f) < math.Cos(halfAngle) {
+			return false
+		}
+	}
+
+	for _, box := range occluders {
+		if segmentIntersectsAABB(observerPos, d, box) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// segmentIntersectsAABB reports if the segment from origin to origin + d
+// intersects a box
+func segmentIntersectsAABB(origin, d Vector, box AABB) bool {
+	_, _, hit := clipAABB(origin, d, box, 0, 1)
+	return hit
+}
+
+// clipAABB clips the line origin + d * t for t in [tmin, tmax] against a box
+// using the slab method, it returns the part of the range inside the box
+func clipAABB(origin, d Vector, box AABB, tmin, tmax float64) (float64, float64, bool) {
+	for i := range origin {
+		if i >= len(box.Min) || i >= len(box.Max) {
+			break
+		}
+
+		di := at(d, i)
+
+		if math.Abs(di) < 1e-12 {
+			if origin[i] < box.Min[i] || origin[i] > box.Max[i] {
+				return 0, 0, false
+			}
+
+			continue
+		}
+
+		t1, t2 := (box.Min[i]-origin[i])/di, (box.Max[i]-origin[i])/di
+
+		if t1 > t2 {
+			t1, t2 = t2, t1
+		}
+
+		tmin, tmax = math.Max(tmin, t1), math.Min(tmax, t2)
+
+		if tmin > tmax {
+			return 0, 0, false
+		}
+	}
+
+	return tmin, tmax, true
+}
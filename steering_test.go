@@ -0,0 +1,123 @@
+package vector_test
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/kvartborg/vector"
+)
+
+func TestSteeringLimitsForce(t *testing.T) {
+	a := vector.Agent{Position: vec{0, 0}, Velocity: vec{0, 0}, MaxSpeed: 10, MaxForce: 2}
+
+	for _, force := range []vec{a.Seek(vec{5, 5}), a.Flee(vec{5, 5}), a.Arrive(vec{100, 0}, 10)} {
+		if force.Magnitude() > 2+1e-8 {
+			t.Errorf("steering force %v exceeds max force", force)
+		}
+	}
+}
+
+func TestArriveSlowsDown(t *testing.T) {
+	a := vector.Agent{Position: vec{0, 0}, Velocity: vec{0, 0}, MaxSpeed: 10, MaxForce: 100}
+
+	if force := a.Arrive(vec{5, 0}, 10); !force.Equal(vec{5, 0}) {
+		t.Errorf("expected half speed within the slowing radius, got %v", force)
+	}
+
+	if force := a.Arrive(vec{0, 0}, 10); !force.Equal(vec{0, 0}) {
+		t.Errorf("expected no force at the target, got %v", force)
+	}
+}
+
+func TestWander(t *testing.T) {
+	a := vector.Agent{Position: vec{3, 3}, Velocity: vec{1, 0}, MaxSpeed: 1, MaxForce: 100}
+
+	// with an angle of 0 the target is straight ahead
+	if force := a.Wander(2, 1, 0); !force.Equal(vec{0, 0}) {
+		t.Errorf("expected no force when wandering straight ahead, got %v", force)
+	}
+}
+
+func ExampleAgent_Seek() {
+	a := vector.Agent{Position: vec{0, 0}, Velocity: vec{0, 1}, MaxSpeed: 2, MaxForce: 5}
+
+	fmt.Println(
+		a.Seek(vec{10, 0}),
+	)
+	// Output: [2 -1]
+}
+
+func ExampleAgent_Flee() {
+	a := vector.Agent{Position: vec{0, 0}, Velocity: vec{0, 0}, MaxSpeed: 2, MaxForce: 5}
+
+	fmt.Println(
+		a.Flee(vec{10, 0}),
+	)
+	// Output: [-2 0]
+}
+
+func TestFlocking(t *testing.T) {
+	a := vector.Agent{Position: vec{0, 0}, Velocity: vec{0, 0}, MaxSpeed: 1, MaxForce: 10}
+	positions := []vec{{0, 0}, {1, 0}, {3, 0}, {50, 50}}
+	velocities := []vec{{0, 0}, {0, 2}, {0, 4}, {9, 9}}
+
+	if force := a.Separation(positions, 5); !force.Equal(vec{-1, 0}) {
+		t.Errorf("expected separation away from neighbors, got %v", force)
+	}
+
+	if force, err := a.Alignment(positions, velocities, 5); err != nil || !force.Equal(vec{0, 1}) {
+		t.Errorf("expected alignment with neighbors, got %v %v", force, err)
+	}
+
+	if _, err := a.Alignment(positions, velocities[:2], 5); err != vector.ErrLengthMismatch {
+		t.Errorf("expected ErrLengthMismatch, got %v", err)
+	}
+
+	if force := a.Cohesion(positions, 5); !force.Equal(vec{1, 0}) {
+		t.Errorf("expected cohesion towards neighbors, got %v", force)
+	}
+
+	// the closer neighbor pulls the weighted center towards it
+	b := vector.Agent{Position: vec{0, 0}, Velocity: vec{0, 0}, MaxSpeed: 1, MaxForce: 10}
+
+	if force := b.Cohesion([]vec{{0, 4}, {2, 0}}, 5); force.X() <= force.Y() {
+		t.Errorf("expected cohesion towards the closer neighbor, got %v", force)
+	}
+
+	lonely := vector.Agent{Position: vec{-100, 0}, Velocity: vec{0, 0}, MaxSpeed: 1, MaxForce: 10}
+
+	if force := lonely.Cohesion(positions, 5); !force.Equal(vec{0, 0}) {
+		t.Errorf("expected no force without neighbors, got %v", force)
+	}
+}
+
+func TestInCone(t *testing.T) {
+	observer, facing := vec{0, 0}, vec{1, 0}
+
+	if !vector.InCone(observer, facing, vec{5, 2}, math.Pi/4, 10) {
+		t.Error("expected target in front to be visible")
+	}
+
+	if vector.InCone(observer, facing, vec{5, 6}, math.Pi/4, 10) {
+		t.Error("expected target outside the angle to be hidden")
+	}
+
+	if vector.InCone(observer, facing, vec{20, 0}, math.Pi/4, 10) {
+		t.Error("expected target beyond max distance to be hidden")
+	}
+
+	wall := vector.AABB{Min: vec{2, -1}, Max: vec{3, 1}}
+
+	if vector.InCone(observer, facing, vec{5, 0}, math.Pi/4, 10, wall) {
+		t.Error("expected target behind a wall to be hidden")
+	}
+
+	if !vector.InCone(observer, facing, vec{5, 3}, math.Pi/4, 10, wall) {
+		t.Error("expected target next to a wall to be visible")
+	}
+
+	if !vector.InCone(observer, facing, vec{1.5, 0}, math.Pi/4, 10, wall) {
+		t.Error("expected target in front of a wall to be visible")
+	}
+}
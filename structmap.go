@@ -0,0 +1,167 @@
+package vector
+
+import (
+	"errors"
+	"reflect"
+)
+
+var (
+	// ErrNotStructPointer is returned when a struct mapping function is called
+	// with something else than a pointer to a struct
+	ErrNotStructPointer = errors.New("value is not a pointer to a struct")
+
+	// ErrUnsupportedField is returned when a field tagged with `vector` is not
+	// an exported numeric field
+	ErrUnsupportedField = errors.New("tagged field is not an exported number")
+
+	// ErrInvalidTag is returned when a `vector` struct tag is not an axis name
+	// or index, or when the same axis is tagged on more than one field
+	ErrInvalidTag = errors.New("invalid vector struct tag")
+)
+
+// axis names that can be used in `vector` struct tags
+var tagAxis = map[string]int{"x": 0, "y": 1, "z": 2, "w": 3}
+
+// FromStruct creates a vector from the fields of a pointer to a struct that
+// are tagged with `vector:"x"`, `vector:"y"`, `vector:"z"` or `vector:"w"`.
+// The tag can also be an index like `vector:"4"` for higher dimensions. The
+// dimension of the vector is the highest tagged axis, axis without a field
+// will be 0. Fields tagged with `vector:"-"` are ignored.
+//
+//	type Position struct {
+//		X float64 `vector:"x"`
+//		Y float64 `vector:"y"`
+//	}
+//
+//	v, err := vector.FromStruct(&Position{1, 2})
+func FromStruct(s interface{}) (Vector, error) {
+	fields, err := taggedFields(s)
+
+	if err != nil {
+		return nil, err
+	}
+
+	dim := 0
+
+	for i := range fields {
+		if fields[i].axis >= dim {
+			dim = fields[i].axis + 1
+		}
+	}
+
+	v := make(Vector, dim)
+
+	for _, f := range fields {
+		switch f.value.Kind() {
+		case reflect.Float32, reflect.Float64:
+			v[f.axis] = f.value.Float()
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			v[f.axis] = float64(f.value.Int())
+		default:
+			v[f.axis] = float64(f.value.Uint())
+		}
+	}
+
+	return v, nil
+}
+
+// ToStruct writes the components of the vector into the tagged fields of a
+// struct, see FromStruct for the supported tags. Fields for axis that the
+// vector does not have are set to 0, integer fields are truncated.
+func (v Vector) ToStruct(s interface{}) error {
+	fields, err := taggedFields(s)
+
+	if err != nil {
+		return err
+	}
+
+	for _, f := range fields {
+		scalar := at(v, f.axis)
+
+		switch f.value.Kind() {
+		case reflect.Float32, reflect.Float64:
+			f.value.SetFloat(scalar)
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			f.value.SetInt(int64(scalar))
+		default:
+			f.value.SetUint(uint64(scalar))
+		}
+	}
+
+	return nil
+}
+
+type taggedField struct {
+	axis  int
+	value reflect.Value
+}
+
+func taggedFields(s interface{}) ([]taggedField, error) {
+	ptr := reflect.ValueOf(s)
+
+	if ptr.Kind() != reflect.Ptr || ptr.IsNil() || ptr.Elem().Kind() != reflect.Struct {
+		return nil, ErrNotStructPointer
+	}
+
+	value := ptr.Elem()
+	fields := []taggedField{}
+	seen := map[int]bool{}
+
+	for i := 0; i < value.NumField(); i++ {
+		tag, ok := value.Type().Field(i).Tag.Lookup("vector")
+
+		if !ok || tag == "-" {
+			continue
+		}
+
+		axis, ok := parseTagAxis(tag)
+
+		if !ok || seen[axis] {
+			return nil, ErrInvalidTag
+		}
+
+		seen[axis] = true
+
+		field := value.Field(i)
+
+		switch field.Kind() {
+		case reflect.Float32, reflect.Float64,
+			reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+			reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		default:
+			return nil, ErrUnsupportedField
+		}
+
+		if !field.CanSet() {
+			return nil, ErrUnsupportedField
+		}
+
+		fields = append(fields, taggedField{axis, field})
+	}
+
+	return fields, nil
+}
+
+func parseTagAxis(tag string) (int, bool) {
+	if axis, ok := tagAxis[tag]; ok {
+		return axis, true
+	}
+
+	if tag == "" {
+		return 0, false
+	}
+
+	axis := 0
+
+	for _, c := range tag {
+		if c < '0' || c > '9' {
+			return 0, false
+		}
+
+		if axis = axis*10 + int(c-'0'); axis > 1<<16 {
+			return 0, false
+		}
+	}
+
+	return axis, true
+}
@@ -0,0 +1,99 @@
+package vector_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/kvartborg/vector"
+)
+
+type transform struct {
+	Name string
+	X    float64 `vector:"x"`
+	Y    float32 `vector:"y"`
+	Z    int     `vector:"z"`
+	Skip float64 `vector:"-"`
+}
+
+func TestStructRoundTrip(t *testing.T) {
+	v, err := vector.FromStruct(&transform{X: 1, Y: 2, Z: 3, Skip: 4})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !v.Equal(vec{1, 2, 3}) {
+		t.Errorf("expected [1 2 3], got %v", v)
+	}
+
+	s := transform{Name: "player"}
+
+	if err := (vec{4, 5}).ToStruct(&s); err != nil {
+		t.Fatal(err)
+	}
+
+	if s.X != 4 || s.Y != 5 || s.Z != 0 || s.Name != "player" {
+		t.Errorf("did not write vector into struct, got %+v", s)
+	}
+}
+
+func TestStructInvalid(t *testing.T) {
+	if _, err := vector.FromStruct(transform{}); err != vector.ErrNotStructPointer {
+		t.Error("expected struct values to be rejected")
+	}
+
+	invalid := struct {
+		X string `vector:"x"`
+	}{}
+
+	if _, err := vector.FromStruct(&invalid); err != vector.ErrUnsupportedField {
+		t.Error("expected non numeric fields to be rejected")
+	}
+
+	unknown := struct {
+		X float64 `vector:"u"`
+	}{}
+
+	if _, err := vector.FromStruct(&unknown); err != vector.ErrInvalidTag {
+		t.Error("expected unknown tags to be rejected")
+	}
+
+	if err := (vec{1}).ToStruct(&unknown); err != vector.ErrInvalidTag {
+		t.Error("expected unknown tags to be rejected")
+	}
+
+	duplicate := struct {
+		X float64 `vector:"x"`
+		Y float64 `vector:"0"`
+	}{}
+
+	if _, err := vector.FromStruct(&duplicate); err != vector.ErrInvalidTag {
+		t.Error("expected duplicate tags to be rejected")
+	}
+
+	if err := (vec{1}).ToStruct(&duplicate); err != vector.ErrInvalidTag {
+		t.Error("expected duplicate tags to be rejected")
+	}
+}
+
+func ExampleFromStruct() {
+	type velocity struct {
+		DX float64 `vector:"x"`
+		DY float64 `vector:"y"`
+	}
+
+	fmt.Println(
+		vector.FromStruct(&velocity{1, 2}),
+	)
+	// Output: [1 2] <nil>
+}
+
+func ExampleVector_ToStruct() {
+	p := struct {
+		X float64 `vector:"x"`
+		Y float64 `vector:"y"`
+	}{}
+
+	fmt.Println(vec{3, 4}.ToStruct(&p), p.X, p.Y)
+	// Output: <nil> 3 4
+}
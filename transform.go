@@ -0,0 +1,151 @@
+package vector
+
+// Transform describes a scale followed by a rotation and a translation to a
+// position. A missing scale component is treated as 1, a missing position
+// component as 0 and a zero rotation as no rotation, which makes the zero
+// value the identity transform.
+type Transform struct {
+	Position Vector
+	Rotation Quaternion
+	Scale    Vector
+}
+
+// Apply returns a new vector with the transform applied to v, the vector is
+// padded to the dimension of the position and when the transform has a
+// rotation to at least 3 dimensions
+func (t Transform) Apply(v Vector) Vector {
+	n := len(v)
+
+	if len(t.Position) > n {
+		n = len(t.Position)
+	}
+
+	result := make(Vector, n)
+
+	for i, x := range v {
+		if i < len(t.Scale) {
+			x *= t.Scale[i]
+		}
+
+		result[i] = x
+	}
+
+	result = t.rotate(result)
+
+	for i := range result {
+		result[i] += at(t.Position, i)
+	}
+
+	return result
+}
+
+// Inverse returns the transform that undoes t, scale components of 0 can
+// not be undone and are kept at 0. A transform with both a rotation and a
+// non-uniform scale can not be undone exactly by a single transform.
+func (t Transform) Inverse() Transform {
+	n := len(t.Position)
+
+	if len(t.Scale) > n {
+		n = len(t.Scale)
+	}
+
+	inv := Transform{Position: make(Vector, n), Scale: make(Vector, n)}
+
+	if t.hasRotation() {
+		inv.Rotation = t.Rotation.Inverse()
+	}
+
+	position := inv.rotate(t.Position.Clone())
+
+	for i := 0; i < n; i++ {
+		s := 1.
+
+		if i < len(t.Scale) {
+			s = t.Scale[i]
+		}
+
+		if s != 0 {
+			inv.Scale[i] = 1 / s
+		}
+
+		inv.Position[i] = -at(position, i) * inv.Scale[i]
+	}
+
+	return inv
+}
+
+// Mul combines the transform of a parent with the transform of a child,
+// the result applies the child and then the parent. The result is exact
+// when the scale of the parent is uniform.
+func (t Transform) Mul(child Transform) Transform {
+	n := len(t.Scale)
+
+	if len(child.Scale) > n {
+		n = len(child.Scale)
+	}
+
+	scale := make(Vector, n)
+
+	for i := range scale {
+		scale[i] = 1
+
+		if i < len(t.Scale) {
+			scale[i] *= t.Scale[i]
+		}
+
+		if i < len(child.Scale) {
+			scale[i] *= child.Scale[i]
+		}
+	}
+
+	rotation := child.Rotation
+
+	if t.hasRotation() {
+		rotation = t.Rotation
+
+		if child.hasRotation() {
+			rotation = t.Rotation.Mul(child.Rotation)
+		}
+	}
+
+	return Transform{
+		Position: t.Apply(child.Position),
+		Rotation: rotation,
+		Scale:    scale,
+	}
+}
+
+// Matrix4 returns the transform as a 4x4 matrix, the transform is cut to 3
+// dimensions
+func (t Transform) Matrix4() Matrix4 {
+	m := Identity4()
+
+	if t.hasRotation() {
+		m = t.Rotation.Matrix3().Matrix4()
+	}
+
+	return m.Mul(Scaling4(t.Scale)).Translate(t.Position)
+}
+
+// hasRotation reports if the transform has a rotation, the zero quaternion
+// is treated as no rotation
+func (t Transform) hasRotation() bool {
+	q := t.Rotation
+	return q.X != 0 || q.Y != 0 || q.Z != 0
+}
+
+// rotate applies the rotation of the transform to v
+func (t Transform) rotate(v Vector) Vector {
+	if !t.hasRotation() {
+		return v
+	}
+
+	r := t.Rotation.RotateVector(v)
+
+	if len(v) > 3 {
+		copy(v, r)
+		return v
+	}
+
+	return r
+}
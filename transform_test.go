@@ -0,0 +1,84 @@
+package vector_test
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/kvartborg/vector"
+)
+
+func TestTransformInverse(t *testing.T) {
+	tr := vector.Transform{Position: vec{1, 2, 3}, Scale: vec{2, 4}}
+	v := vec{5, -1, 2}
+
+	if result := tr.Inverse().Apply(tr.Apply(v)); !result.Equal(v) {
+		t.Errorf("expected %v, got %v", v, result)
+	}
+}
+
+func TestTransformWithRotation(t *testing.T) {
+	tr := vector.Transform{
+		Position: vec{1, 2, 3},
+		Rotation: vector.QuaternionFromAxisAngle(vec{0, 0, 1}, math.Pi/2),
+		Scale:    vec{2, 2, 2},
+	}
+
+	if p := tr.Apply(vec{1, 0, 0}); !p.Equal(vec{1, 4, 3}) {
+		t.Errorf("expected [1 4 3], got %v", p)
+	}
+
+	if p := tr.Inverse().Apply(vec{1, 4, 3}); !p.Equal(vec{1, 0, 0}) {
+		t.Errorf("expected the inverse to undo the transform, got %v", p)
+	}
+
+	if p := tr.Matrix4().TransformPoint(vec{1, 0, 0}); !p.Equal(vec{1, 4, 3}) {
+		t.Errorf("expected the matrix to match the transform, got %v", p)
+	}
+}
+
+func TestTransformMul(t *testing.T) {
+	parent := vector.Transform{
+		Position: vec{10, 0, 0},
+		Rotation: vector.QuaternionFromAxisAngle(vec{0, 1, 0}, 0.4),
+		Scale:    vec{3, 3, 3},
+	}
+	child := vector.Transform{
+		Position: vec{0, 1, 0},
+		Rotation: vector.QuaternionFromAxisAngle(vec{1, 0, 0}, 1.3),
+		Scale:    vec{1, 2, 1},
+	}
+	v := vec{1, 2, 3}
+	expected := parent.Apply(child.Apply(v))
+
+	if result := parent.Mul(child).Apply(v); !result.Equal(expected) {
+		t.Errorf("expected %v, got %v", expected, result)
+	}
+}
+
+func ExampleTransform_Apply() {
+	tr := vector.Transform{Position: vec{1, 1}, Scale: vec{2, 3}}
+
+	fmt.Println(
+		tr.Apply(vec{1, 2}),
+		vector.Transform{}.Apply(vec{1, 2}),
+	)
+	// Output: [3 7] [1 2]
+}
+
+func TestTransformMulEmptyChild(t *testing.T) {
+	parent := vector.Transform{
+		Position: vec{1, 2, 3},
+		Rotation: vector.QuaternionFromAxisAngle(vec{0, 0, 1}, math.Pi/2),
+	}
+
+	for _, child := range []vector.Transform{{}, {Scale: vec{1, 1, 1}}} {
+		if p := parent.Mul(child).Apply(vec{0, 0, 0}); !p.Equal(vec{1, 2, 3}) {
+			t.Errorf("expected the translation of the parent to be kept, got %v", p)
+		}
+	}
+
+	if p := (vector.Transform{Position: vec{1, 2, 3}}).Apply(vec{1}); !p.Equal(vec{2, 2, 3}) {
+		t.Errorf("expected the vector to be padded to the dimension of the position, got %v", p)
+	}
+}
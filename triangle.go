@@ -0,0 +1,111 @@
+package vector
+
+import "math"
+
+// Triangle is a triangle with the corners A, B and C
+type Triangle struct {
+	A, B, C Vector
+}
+
+// Normal returns the unit normal of a 3-dimensional triangle, it points
+// toward the side where the corners are seen counterclockwise
+func (t Triangle) Normal() (Vector, error) {
+	n, err := Cross(Sub(t.B, t.A), Sub(t.C, t.A))
+
+	if err != nil {
+		return nil, err
+	}
+
+	if n.Magnitude() < 1e-8 {
+		return nil, ErrCollinearPoints
+	}
+
+	return n.Unit(), nil
+}
+
+// Area returns the area of the triangle in any number of dimensions
+func (t Triangle) Area() float64 {
+	ab, ac := Sub(t.B, t.A), Sub(t.C, t.A)
+	d := ab.MagnitudeSquared()*ac.MagnitudeSquared() - Dot(ab, ac)*Dot(ab, ac)
+
+	return math.Sqrt(math.Max(0, d)) / 2
+}
+
+// Barycentric returns the barycentric coordinates (u, v, w) of a point, so
+// that the point is u*A + v*B + w*C. A point outside the plane of the
+// triangle is projected onto it.
+func (t Triangle) Barycentric(p Vector) (Vector, error) {
+	ab, ac, ap := Sub(t.B, t.A), Sub(t.C, t.A), Sub(p, t.A)
+	d00, d01, d11 := Dot(ab, ab), Dot(ab, ac), Dot(ac, ac)
+	d20, d21 := Dot(ap, ab), Dot(ap, ac)
+	denom := d00*d11 - d01*d01
+
+	if math.Abs(denom) < 1e-12 {
+		return nil, ErrCollinearPoints
+	}
+
+	v := (d11*d20 - d01*d21) / denom
+	w := (d00*d21 - d01*d20) / denom
+
+	return Vector{1 - v - w, v, w}, nil
+}
+
+// ContainsPoint reports if a point is inside the triangle or on its edges,
+// a point outside the plane of the triangle is projected onto it. A
+// degenerate triangle contains no points.
+func (t Triangle) ContainsPoint(p Vector) bool {
+	b, err := t.Barycentric(p)
+
+	if err != nil {
+		return false
+	}
+
+	return b[0] >= -1e-12 && b[1] >= -1e-12 && b[2] >= -1e-12
+}
+
+// ClosestPoint returns the point on the triangle closest to p
+func (t Triangle) ClosestPoint(p Vector) Vector {
+	ab, ac, ap := Sub(t.B, t.A), Sub(t.C, t.A), Sub(p, t.A)
+	d1, d2 := Dot(ab, ap), Dot(ac, ap)
+
+	// check the region of each corner, then each edge, then the face
+	if d1 <= 0 && d2 <= 0 {
+		return t.A.Clone()
+	}
+
+	bp := Sub(p, t.B)
+	d3, d4 := Dot(ab, bp), Dot(ac, bp)
+
+	if d3 >= 0 && d4 <= d3 {
+		return t.B.Clone()
+	}
+
+	cp := Sub(p, t.C)
+	d5, d6 := Dot(ab, cp), Dot(ac, cp)
+
+	if d6 >= 0 && d5 <= d6 {
+		return t.C.Clone()
+	}
+
+	vc := d1*d4 - d3*d2
+
+	if vc <= 0 && d1 >= 0 && d3 <= 0 {
+		return AddScaled(t.A, ab, d1/(d1-d3))
+	}
+
+	vb := d5*d2 - d1*d6
+
+	if vb <= 0 && d2 >= 0 && d6 <= 0 {
+		return AddScaled(t.A, ac, d2/(d2-d6))
+	}
+
+	va := d3*d6 - d5*d4
+
+	if va <= 0 && d4-d3 >= 0 && d5-d6 >= 0 {
+		return AddScaled(t.B, Sub(t.C, t.B), (d4-d3)/((d4-d3)+(d5-d6)))
+	}
+
+	denom := 1 / (va + vb + vc)
+
+	return AddScaled(t.A, ab, vb*denom).AddScaled(ac, vc*denom)
+}
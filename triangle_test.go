@@ -0,0 +1,66 @@
+package vector_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/kvartborg/vector"
+)
+
+func TestTriangleClosestPoint(t *testing.T) {
+	tri := vector.Triangle{A: vec{0, 0, 0}, B: vec{4, 0, 0}, C: vec{0, 4, 0}}
+
+	for _, c := range [][2]vec{
+		{{1, 1, 5}, {1, 1, 0}},
+		{{-1, -1, 0}, {0, 0, 0}},
+		{{6, -1, 0}, {4, 0, 0}},
+		{{2, -3, 1}, {2, 0, 0}},
+		{{-2, 2, 0}, {0, 2, 0}},
+		{{3, 3, 0}, {2, 2, 0}},
+	} {
+		if p := tri.ClosestPoint(c[0]); !p.Equal(c[1]) {
+			t.Errorf("expected the closest point to %v to be %v, got %v", c[0], c[1], p)
+		}
+	}
+}
+
+func TestTriangleDegenerate(t *testing.T) {
+	tri := vector.Triangle{A: vec{0, 0, 0}, B: vec{1, 1, 1}, C: vec{2, 2, 2}}
+
+	if _, err := tri.Normal(); err != vector.ErrCollinearPoints {
+		t.Errorf("expected ErrCollinearPoints, got %v", err)
+	}
+
+	if tri.ContainsPoint(vec{1, 1, 1}) || tri.Area() != 0 {
+		t.Error("expected a degenerate triangle to have no area and contain no points")
+	}
+}
+
+func ExampleTriangle_Barycentric() {
+	tri := vector.Triangle{A: vec{0, 0}, B: vec{4, 0}, C: vec{0, 4}}
+
+	fmt.Println(
+		tri.Barycentric(vec{1, 2}),
+	)
+	// Output: [0.25 0.25 0.5] <nil>
+}
+
+func ExampleTriangle_ContainsPoint() {
+	tri := vector.Triangle{A: vec{0, 0}, B: vec{4, 0}, C: vec{0, 4}}
+
+	fmt.Println(
+		tri.ContainsPoint(vec{1, 1}),
+		tri.ContainsPoint(vec{3, 3}),
+		tri.Area(),
+	)
+	// Output: true false 8
+}
+
+func ExampleTriangle_Normal() {
+	tri := vector.Triangle{A: vec{0, 0, 0}, B: vec{4, 0, 0}, C: vec{0, 4, 0}}
+
+	fmt.Println(
+		tri.Normal(),
+	)
+	// Output: [0 0 1] <nil>
+}
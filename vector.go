@@ -19,12 +19,25 @@ const (
 	X Axis = iota
 	Y
 	Z
+	W
 )
 
 var (
 	// ErrNot3Dimensional is an error that is returned in functions that only
 	// supports 3 dimensional vectors
 	ErrNot3Dimensional = errors.New("vector is not 3 dimensional")
+
+	// ErrZeroVector is returned in functions that needs a direction but
+	// received a vector with a length of zero
+	ErrZeroVector = errors.New("vector has zero length")
+
+	// ErrLengthMismatch is returned in functions that takes multiple slices
+	// which are expected to have the same length
+	ErrLengthMismatch = errors.New("slices does not have the same length")
+
+	// ErrDivisionByZero is returned when dividing by a vector with a
+	// component of zero
+	ErrDivisionByZero = errors.New("division by zero")
 )
 
 // Clone a vector
@@ -117,13 +130,24 @@ func Magnitude(v Vector) float64 {
 
 // Magnitude of a vector
 func (v Vector) Magnitude() float64 {
+	return math.Sqrt(v.MagnitudeSquared())
+}
+
+// MagnitudeSquared of a vector, it avoids the square root of Magnitude which
+// makes it faster for comparisons
+func MagnitudeSquared(v Vector) float64 {
+	return v.MagnitudeSquared()
+}
+
+// MagnitudeSquared of a vector
+func (v Vector) MagnitudeSquared() float64 {
 	var result float64
 
 	for _, scalar := range v {
 		result += scalar * scalar
 	}
 
-	return math.Sqrt(result)
+	return result
 }
 
 // Unit returns a direction vector with the length of one.
@@ -133,17 +157,47 @@ func Unit(v Vector) Vector {
 
 // Unit returns a direction vector with the length of one.
 func (v Vector) Unit() Vector {
+	v, _ = v.Normalized()
+	return v
+}
+
+// UnitChecked returns a direction vector with the length of one, or
+// ErrZeroVector if the vector has no direction
+func UnitChecked(v Vector) (Vector, error) {
+	return v.Clone().UnitChecked()
+}
+
+// UnitChecked scales the vector to a length of one, unlike Unit a vector
+// with a length below 1e-8 is an error and the vector is left unchanged
+func (v Vector) UnitChecked() (Vector, error) {
+	if v, l := v.Normalized(); l >= 1e-8 {
+		return v, nil
+	}
+
+	return nil, ErrZeroVector
+}
+
+// Normalized returns a direction vector with the length of one and the
+// length of the original vector
+func Normalized(v Vector) (Vector, float64) {
+	return v.Clone().Normalized()
+}
+
+// Normalized scales the vector to a length of one and returns the length it
+// had before, the length only needs to be calculated once. A zero vector is
+// returned unchanged like Unit.
+func (v Vector) Normalized() (Vector, float64) {
 	l := v.Magnitude()
 
 	if l < 1e-8 {
-		return v
+		return v, l
 	}
 
 	for i := range v {
 		v[i] = v[i] / l
 	}
 
-	return v
+	return v, l
 }
 
 // Dot product of two vectors
@@ -184,7 +238,7 @@ func (v Vector) Cross(v2 Vector) (Vector, error) {
 	return Vector{
 		v[Y]*v2[Z] - v[Z]*v2[Y],
 		v[Z]*v2[X] - v[X]*v2[Z],
-		v[X]*v2[Z] - v[Z]*v2[X],
+		v[X]*v2[Y] - v[Y]*v2[X],
 	}, nil
 }
 
@@ -254,6 +308,977 @@ func (v Vector) Rotate(angle float64, as ...Axis) Vector {
 	return v
 }
 
+// RotateAroundAxisPoint rotates a vector around an arbitrary axis that goes
+// through a pivot point, the rotation is counterclockwise when looking down
+// the axis towards the pivot.
+//
+// The vector is treated as 3-dimensional, missing dimensions are added and
+// extra dimensions are cut. If the axis has a length of zero, the vector is
+// returned unchanged.
+func RotateAroundAxisPoint(v, axis, pivot Vector, angle float64) Vector {
+	return v.Clone().RotateAroundAxisPoint(axis, pivot, angle)
+}
+
+// RotateAroundAxisPoint rotates a vector around an arbitrary axis that goes
+// through a pivot point, the rotation is counterclockwise when looking down
+// the axis towards the pivot.
+//
+// The vector is treated as 3-dimensional, missing dimensions are added and
+// extra dimensions are cut. If the axis has a length of zero, the vector is
+// returned unchanged.
+func (v Vector) RotateAroundAxisPoint(axis, pivot Vector, angle float64) Vector {
+	for len(v) < 3 {
+		v = append(v, 0)
+	}
+
+	v = v[:3]
+	kx, ky, kz := axis.X(), axis.Y(), axis.Z()
+	l := math.Sqrt(kx*kx + ky*ky + kz*kz)
+
+	if l < 1e-8 {
+		return v
+	}
+
+	kx, ky, kz = kx/l, ky/l, kz/l
+	px, py, pz := pivot.X(), pivot.Y(), pivot.Z()
+	x, y, z := v[X]-px, v[Y]-py, v[Z]-pz
+
+	sin, cos := math.Sincos(angle)
+	dot := (kx*x + ky*y + kz*z) * (1 - cos)
+
+	v[X] = px + x*cos + (ky*z-kz*y)*sin + kx*dot
+	v[Y] = py + y*cos + (kz*x-kx*z)*sin + ky*dot
+	v[Z] = pz + z*cos + (kx*y-ky*x)*sin + kz*dot
+
+	return v
+}
+
+// MirrorAcrossPlane reflects a point to the opposite side of a plane
+func MirrorAcrossPlane(v Vector, p Plane) Vector {
+	return v.Clone().MirrorAcrossPlane(p)
+}
+
+// MirrorAcrossPlane reflects a point to the opposite side of a plane
+func (v Vector) MirrorAcrossPlane(p Plane) Vector {
+	dim := len(v)
+	n := p.Normal
+
+	if len(n) > dim {
+		n = n[:dim]
+	}
+
+	axpyUnitaryTo(v, -2*(Dot(v, p.Normal)+p.D), n, v)
+	return v
+}
+
+// MirrorAxis flips the sign of a single axis of a vector
+func MirrorAxis(v Vector, a Axis) Vector {
+	return v.Clone().MirrorAxis(a)
+}
+
+// MirrorAxis flips the sign of a single axis of a vector, if the vector does
+// not have the axis it is returned unchanged
+func (v Vector) MirrorAxis(a Axis) Vector {
+	if int(a) < len(v) {
+		v[a] = -v[a]
+	}
+
+	return v
+}
+
+// Refract bends a direction passing through a surface with the given normal
+// using Snell's law, eta is the ratio between the refractive indices of the
+// two materials. Both vectors are expected to have a length of one and the
+// normal to point against the direction, a zero vector is returned on total
+// internal reflection.
+func Refract(v, normal Vector, eta float64) Vector {
+	return v.Clone().Refract(normal, eta)
+}
+
+// Refract bends a direction passing through a surface with the given normal
+// using Snell's law, eta is the ratio between the refractive indices of the
+// two materials. A zero vector is returned on total internal reflection.
+func (v Vector) Refract(normal Vector, eta float64) Vector {
+	if len(normal) > len(v) {
+		normal = normal[:len(v)]
+	}
+
+	cos := Dot(v, normal)
+	k := 1 - eta*eta*(1-cos*cos)
+
+	if k < 0 {
+		for i := range v {
+			v[i] = 0
+		}
+
+		return v
+	}
+
+	v.Scale(eta)
+	axpyUnitaryTo(v, -(eta*cos + math.Sqrt(k)), normal, v)
+
+	return v
+}
+
+// Project returns the part of v that is parallel to another vector
+func Project(v, onto Vector) Vector {
+	return v.Clone().Project(onto)
+}
+
+// Project sets v to the part of it that is parallel to another vector, a
+// zero vector is the result when projecting onto a zero vector
+func (v Vector) Project(onto Vector) Vector {
+	s := 0.
+
+	if l := Dot(onto, onto); l > 1e-16 {
+		s = Dot(v, onto) / l
+	}
+
+	for i := range v {
+		v[i] = at(onto, i) * s
+	}
+
+	return v
+}
+
+// Reject returns the part of v that is perpendicular to another vector
+func Reject(v, onto Vector) Vector {
+	return v.Clone().Reject(onto)
+}
+
+// Reject sets v to the part of it that is perpendicular to another vector,
+// it is the remainder of Project. Sliding along a wall is the rejection of
+// the velocity from the normal of the wall.
+func (v Vector) Reject(onto Vector) Vector {
+	return v.Sub(Project(v, onto))
+}
+
+// Distance returns the distance between two points
+func Distance(v1, v2 Vector) float64 {
+	return v1.Distance(v2)
+}
+
+// Distance returns the distance between two points, missing components are
+// treated as 0
+func (v Vector) Distance(v2 Vector) float64 {
+	return math.Sqrt(v.DistanceSquared(v2))
+}
+
+// DistanceSquared returns the squared distance between two points, it
+// avoids the square root of Distance which makes it faster for comparisons
+func DistanceSquared(v1, v2 Vector) float64 {
+	return v1.DistanceSquared(v2)
+}
+
+// DistanceSquared returns the squared distance between two points, missing
+// components are treated as 0
+func (v Vector) DistanceSquared(v2 Vector) float64 {
+	if len(v) < len(v2) {
+		v, v2 = v2, v
+	}
+
+	var result float64
+
+	for i := range v {
+		d := v[i] - at(v2, i)
+		result += d * d
+	}
+
+	return result
+}
+
+// ClampMagnitude limits the length of a vector to a maximum
+func ClampMagnitude(v Vector, max float64) Vector {
+	return v.Clone().ClampMagnitude(max)
+}
+
+// ClampMagnitude scales the vector down to the max length if it is longer,
+// the direction is kept
+func (v Vector) ClampMagnitude(max float64) Vector {
+	if l := v.MagnitudeSquared(); l > max*max {
+		v.Scale(max / math.Sqrt(l))
+	}
+
+	return v
+}
+
+// ClampMagnitudeRange limits the length of a vector to be between a minimum
+// and a maximum
+func ClampMagnitudeRange(v Vector, min, max float64) Vector {
+	return v.Clone().ClampMagnitudeRange(min, max)
+}
+
+// ClampMagnitudeRange scales the vector so its length is between min and
+// max, the direction is kept. A zero vector has no direction and is left
+// unchanged.
+func (v Vector) ClampMagnitudeRange(min, max float64) Vector {
+	l := v.Magnitude()
+
+	if l < 1e-8 {
+		return v
+	}
+
+	if l < min {
+		return v.Scale(min / l)
+	}
+
+	if l > max {
+		return v.Scale(max / l)
+	}
+
+	return v
+}
+
+// Clamp each component of a vector between the components of a min and a
+// max vector
+func Clamp(v, min, max Vector) Vector {
+	return v.Clone().Clamp(min, max)
+}
+
+// Clamp each component of a vector between the components of a min and a
+// max vector, like Add components missing in min or max are left unchanged
+// and extra components are ignored
+func (v Vector) Clamp(min, max Vector) Vector {
+	for i := range v {
+		if i < len(min) && v[i] < min[i] {
+			v[i] = min[i]
+		}
+
+		if i < len(max) && v[i] > max[i] {
+			v[i] = max[i]
+		}
+	}
+
+	return v
+}
+
+// Min returns the smallest value of each component of two vectors
+func Min(v1, v2 Vector) Vector {
+	return v1.Clone().Min(v2)
+}
+
+// Min sets each component of a vector to the smallest value of it and the
+// component of another vector, like Add missing components are left
+// unchanged
+func (v Vector) Min(v2 Vector) Vector {
+	for i := range v {
+		if i < len(v2) {
+			v[i] = math.Min(v[i], v2[i])
+		}
+	}
+
+	return v
+}
+
+// Max returns the largest value of each component of two vectors
+func Max(v1, v2 Vector) Vector {
+	return v1.Clone().Max(v2)
+}
+
+// Max sets each component of a vector to the largest value of it and the
+// component of another vector, like Add missing components are left
+// unchanged
+func (v Vector) Max(v2 Vector) Vector {
+	for i := range v {
+		if i < len(v2) {
+			v[i] = math.Max(v[i], v2[i])
+		}
+	}
+
+	return v
+}
+
+// Abs returns the absolute value of each component of a vector
+func Abs(v Vector) Vector {
+	return v.Clone().Abs()
+}
+
+// Abs sets each component of a vector to its absolute value
+func (v Vector) Abs() Vector {
+	for i := range v {
+		v[i] = math.Abs(v[i])
+	}
+
+	return v
+}
+
+// Negate returns a vector pointing in the opposite direction
+func Negate(v Vector) Vector {
+	return v.Clone().Negate()
+}
+
+// Negate flips the sign of each component of a vector
+func (v Vector) Negate() Vector {
+	for i := range v {
+		v[i] = -v[i]
+	}
+
+	return v
+}
+
+// Invert returns a vector pointing in the opposite direction, it is the
+// same as Negate
+func Invert(v Vector) Vector {
+	return v.Clone().Negate()
+}
+
+// Invert flips the sign of each component of a vector, it is the same as
+// Negate
+func (v Vector) Invert() Vector {
+	return v.Negate()
+}
+
+// InvertComponents returns the reciprocal of each component of a vector
+func InvertComponents(v Vector) Vector {
+	return v.Clone().InvertComponents()
+}
+
+// InvertComponents sets each component of a vector to its reciprocal,
+// components of 0 becomes infinite
+func (v Vector) InvertComponents() Vector {
+	for i := range v {
+		v[i] = 1 / v[i]
+	}
+
+	return v
+}
+
+// Floor rounds each component of a vector down
+func Floor(v Vector) Vector {
+	return v.Clone().Floor()
+}
+
+// Floor rounds each component of a vector down to the nearest integer
+func (v Vector) Floor() Vector {
+	for i := range v {
+		v[i] = math.Floor(v[i])
+	}
+
+	return v
+}
+
+// Ceil rounds each component of a vector up
+func Ceil(v Vector) Vector {
+	return v.Clone().Ceil()
+}
+
+// Ceil rounds each component of a vector up to the nearest integer
+func (v Vector) Ceil() Vector {
+	for i := range v {
+		v[i] = math.Ceil(v[i])
+	}
+
+	return v
+}
+
+// Round rounds each component of a vector to the nearest integer
+func Round(v Vector) Vector {
+	return v.Clone().Round()
+}
+
+// Round rounds each component of a vector to the nearest integer, halfway
+// values are rounded away from zero
+func (v Vector) Round() Vector {
+	for i := range v {
+		v[i] = math.Round(v[i])
+	}
+
+	return v
+}
+
+// Snap rounds each component of a vector to the nearest multiple of the
+// grid size
+func Snap(v Vector, gridSize float64) Vector {
+	return v.Clone().Snap(gridSize)
+}
+
+// Snap rounds each component of a vector to the nearest multiple of the
+// grid size, a grid size of 0 leaves the vector unchanged
+func (v Vector) Snap(gridSize float64) Vector {
+	if gridSize == 0 {
+		return v
+	}
+
+	for i := range v {
+		v[i] = math.Round(v[i]/gridSize) * gridSize
+	}
+
+	return v
+}
+
+// SnapVec rounds each component of a vector to the nearest multiple of the
+// cell size of the same axis
+func SnapVec(v, cellSizes Vector) Vector {
+	return v.Clone().SnapVec(cellSizes)
+}
+
+// SnapVec rounds each component of a vector to the nearest multiple of the
+// cell size of the same axis, components without a cell size or with a cell
+// size of 0 are left unchanged
+func (v Vector) SnapVec(cellSizes Vector) Vector {
+	for i := range v {
+		if i < len(cellSizes) && cellSizes[i] != 0 {
+			v[i] = math.Round(v[i]/cellSizes[i]) * cellSizes[i]
+		}
+	}
+
+	return v
+}
+
+// Mult multiplies two vectors component by component
+func Mult(v1, v2 Vector) Vector {
+	return v1.Clone().Mult(v2)
+}
+
+// Mult multiplies each component of a vector with the component of another
+// vector, like Add the other vector is cut to the dimension of the vector
+// and missing components leaves the vector unchanged
+func (v Vector) Mult(v2 Vector) Vector {
+	for i := range v {
+		if i < len(v2) {
+			v[i] *= v2[i]
+		}
+	}
+
+	return v
+}
+
+// Div divides two vectors component by component
+func Div(v1, v2 Vector) (Vector, error) {
+	return v1.Clone().Div(v2)
+}
+
+// Div divides each component of a vector with the component of another
+// vector, like Add the other vector is cut to the dimension of the vector
+// and missing components leaves the vector unchanged. If any of the
+// components used to divide is 0, ErrDivisionByZero is returned and the
+// vector is left unchanged.
+func (v Vector) Div(v2 Vector) (Vector, error) {
+	for i := range v {
+		if i < len(v2) && v2[i] == 0 {
+			return nil, ErrDivisionByZero
+		}
+	}
+
+	for i := range v {
+		if i < len(v2) {
+			v[i] /= v2[i]
+		}
+	}
+
+	return v, nil
+}
+
+// Mod returns each component of a vector modulo m
+func Mod(v Vector, m float64) Vector {
+	return v.Clone().Mod(m)
+}
+
+// Mod sets each component of a vector to its remainder of m, unlike
+// math.Mod the result has the sign of m so negative components wrap around
+// to the other side. A modulo of 0 leaves the vector unchanged.
+func (v Vector) Mod(m float64) Vector {
+	if m == 0 {
+		return v
+	}
+
+	for i := range v {
+		v[i] -= m * math.Floor(v[i]/m)
+	}
+
+	return v
+}
+
+// Wrap returns a vector with each component wrapped into the range between
+// the components of a min and a max vector
+func Wrap(v, min, max Vector) Vector {
+	return v.Clone().Wrap(min, max)
+}
+
+// Wrap wraps each component of a vector into the range [min, max), a
+// component that leaves one side of the range comes back on the other side.
+// Like Add components missing in min or max or with an empty range are left
+// unchanged.
+func (v Vector) Wrap(min, max Vector) Vector {
+	for i := range v {
+		if i >= len(min) || i >= len(max) {
+			continue
+		}
+
+		if size := max[i] - min[i]; size > 0 {
+			v[i] -= size * math.Floor((v[i]-min[i])/size)
+		}
+	}
+
+	return v
+}
+
+// MoveToward returns a point moved toward a target by at most maxDelta
+func MoveToward(v, target Vector, maxDelta float64) Vector {
+	return v.Clone().MoveToward(target, maxDelta)
+}
+
+// MoveToward moves a point toward a target by at most maxDelta without
+// overshooting it, the target is cut to the dimension of the point and
+// missing components are treated as 0
+func (v Vector) MoveToward(target Vector, maxDelta float64) Vector {
+	d := make(Vector, len(v))
+
+	for i := range v {
+		d[i] = at(target, i) - v[i]
+	}
+
+	if l := d.Magnitude(); l > maxDelta && l > 0 {
+		d.Scale(maxDelta / l)
+	}
+
+	return v.Add(d)
+}
+
+// Swizzle returns a new vector with the components of v picked in the order
+// of the letters x, y, z and w, like "zyx" or "xxy"
+func Swizzle(v Vector, order string) Vector {
+	return v.Swizzle(order)
+}
+
+// Swizzle returns a new vector with the components of the vector picked in
+// the order of the letters x, y, z and w, a component can be picked multiple
+// times. Components that the vector does not have and unknown letters are 0.
+func (v Vector) Swizzle(order string) Vector {
+	result := make(Vector, len(order))
+
+	for i, c := range []byte(order) {
+		switch c {
+		case 'x', 'X':
+			result[i] = v.X()
+		case 'y', 'Y':
+			result[i] = v.Y()
+		case 'z', 'Z':
+			result[i] = v.Z()
+		case 'w', 'W':
+			result[i] = v.W()
+		}
+	}
+
+	return result
+}
+
+// SwizzleAxis returns a new vector with the components of v picked in the
+// order of the given axis
+func SwizzleAxis(v Vector, as ...Axis) Vector {
+	return v.SwizzleAxis(as...)
+}
+
+// SwizzleAxis returns a new vector with the components of the vector picked
+// in the order of the given axis, components that the vector does not have
+// are 0
+func (v Vector) SwizzleAxis(as ...Axis) Vector {
+	result := make(Vector, len(as))
+
+	for i, a := range as {
+		result[i] = at(v, int(a))
+	}
+
+	return result
+}
+
+// Resize returns a copy of a vector with n dimensions, extra components are
+// cut and missing components are 0
+func Resize(v Vector, n int) Vector {
+	return v.Clone().Resize(n)
+}
+
+// Resize cuts or zero pads a vector to n dimensions. The vector is returned
+// since growing it may allocate a new one, use it like v = v.Resize(3).
+func (v Vector) Resize(n int) Vector {
+	if n <= len(v) {
+		return v[:n]
+	}
+
+	return append(v, make(Vector, n-len(v))...)
+}
+
+// To2D returns a copy of a vector as a 2-dimensional vector
+func To2D(v Vector) Vector {
+	return Vector{v.X(), v.Y()}
+}
+
+// To2D cuts or zero pads a vector to 2 dimensions, like Resize
+func (v Vector) To2D() Vector {
+	return v.Resize(2)
+}
+
+// To3D returns a copy of a vector as a 3-dimensional vector
+func To3D(v Vector) Vector {
+	return Vector{v.X(), v.Y(), v.Z()}
+}
+
+// To3D cuts or zero pads a vector to 3 dimensions, like Resize
+func (v Vector) To3D() Vector {
+	return v.Resize(3)
+}
+
+// To4D returns a copy of a vector as a 4-dimensional vector with the given w
+// component, a w of 1 makes a homogeneous point and 0 a direction
+func To4D(v Vector, w float64) Vector {
+	return Vector{v.X(), v.Y(), v.Z(), w}
+}
+
+// To4D cuts or zero pads a vector to 4 dimensions and sets the w component,
+// like Resize
+func (v Vector) To4D(w float64) Vector {
+	return v.Resize(4).SetW(w)
+}
+
+// Sum returns the sum of the components of a vector
+func Sum(v Vector) float64 {
+	return v.Sum()
+}
+
+// Sum returns the sum of the components of a vector
+func (v Vector) Sum() float64 {
+	var result float64
+
+	for _, scalar := range v {
+		result += scalar
+	}
+
+	return result
+}
+
+// Product returns the product of the components of a vector
+func Product(v Vector) float64 {
+	return v.Product()
+}
+
+// Product returns the product of the components of a vector, the product of
+// an empty vector is 1
+func (v Vector) Product() float64 {
+	result := 1.
+
+	for _, scalar := range v {
+		result *= scalar
+	}
+
+	return result
+}
+
+// Mean returns the mean of the components of a vector
+func Mean(v Vector) float64 {
+	return v.Mean()
+}
+
+// Mean returns the mean of the components of a vector, the mean of an empty
+// vector is 0
+func (v Vector) Mean() float64 {
+	if len(v) == 0 {
+		return 0
+	}
+
+	return v.Sum() / float64(len(v))
+}
+
+// IsZero reports if all components of a vector are within 1e-8 of zero, the
+// same tolerance Equal uses
+func IsZero(v Vector) bool {
+	return v.IsZero()
+}
+
+// IsZero reports if all components of a vector are within 1e-8 of zero, the
+// same tolerance Equal uses
+func (v Vector) IsZero() bool {
+	return v.IsZeroEps(1e-8)
+}
+
+// IsZeroEps reports if all components of a vector are within eps of zero
+func IsZeroEps(v Vector, eps float64) bool {
+	return v.IsZeroEps(eps)
+}
+
+// IsZeroEps reports if all components of a vector are within eps of zero,
+// useful for dead zones of analog sticks
+func (v Vector) IsZeroEps(eps float64) bool {
+	for _, scalar := range v {
+		if math.Abs(scalar) > eps {
+			return false
+		}
+	}
+
+	return true
+}
+
+// IsValid reports if none of the components of a vector are NaN or infinite
+func IsValid(v Vector) bool {
+	return v.IsValid()
+}
+
+// IsValid reports if none of the components of a vector are NaN or infinite
+func (v Vector) IsValid() bool {
+	for _, scalar := range v {
+		if math.IsNaN(scalar) || math.IsInf(scalar, 0) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Sanitize returns a copy of a vector where NaN and infinite components are
+// replaced with 0
+func Sanitize(v Vector) Vector {
+	return v.Clone().Sanitize()
+}
+
+// Sanitize replaces NaN and infinite components of a vector with 0
+func (v Vector) Sanitize() Vector {
+	for i, scalar := range v {
+		if math.IsNaN(scalar) || math.IsInf(scalar, 0) {
+			v[i] = 0
+		}
+	}
+
+	return v
+}
+
+// ScaleVec scales each axis of a vector by the component of the same axis
+// in scales
+func ScaleVec(v, scales Vector) Vector {
+	return v.Clone().ScaleVec(scales)
+}
+
+// ScaleVec scales each axis of a vector by the component of the same axis
+// in scales, it is the same as Mult so axis without a scale are left
+// unchanged
+func (v Vector) ScaleVec(scales Vector) Vector {
+	return v.Mult(scales)
+}
+
+// DivScalar divides each component of a vector by a scalar
+func DivScalar(v Vector, s float64) (Vector, error) {
+	return v.Clone().DivScalar(s)
+}
+
+// DivScalar divides each component of a vector by a scalar, if the scalar is
+// within 1e-8 of zero ErrDivisionByZero is returned and the vector is left
+// unchanged
+func (v Vector) DivScalar(s float64) (Vector, error) {
+	if math.Abs(s) < 1e-8 {
+		return nil, ErrDivisionByZero
+	}
+
+	return v.Scale(1 / s), nil
+}
+
+// AddScaled returns the result of v1 + v2 * scale
+func AddScaled(v1, v2 Vector, scale float64) Vector {
+	return v1.Clone().AddScaled(v2, scale)
+}
+
+// AddScaled adds another vector multiplied by a scale to the vector in a
+// single step without allocating, like pos.AddScaled(vel, dt). Like Add the
+// other vector is cut to the dimension of the vector.
+func (v Vector) AddScaled(v2 Vector, scale float64) Vector {
+	if len(v2) > len(v) {
+		v2 = v2[:len(v)]
+	}
+
+	axpyUnitaryTo(v, scale, v2, v)
+	return v
+}
+
+// MaxComponent returns the largest component of a vector
+func MaxComponent(v Vector) float64 {
+	return v.MaxComponent()
+}
+
+// MaxComponent returns the largest component of a vector, 0 is returned for
+// an empty vector
+func (v Vector) MaxComponent() float64 {
+	if len(v) == 0 {
+		return 0
+	}
+
+	result := v[0]
+
+	for _, scalar := range v[1:] {
+		result = math.Max(result, scalar)
+	}
+
+	return result
+}
+
+// MinComponent returns the smallest component of a vector
+func MinComponent(v Vector) float64 {
+	return v.MinComponent()
+}
+
+// MinComponent returns the smallest component of a vector, 0 is returned for
+// an empty vector
+func (v Vector) MinComponent() float64 {
+	if len(v) == 0 {
+		return 0
+	}
+
+	result := v[0]
+
+	for _, scalar := range v[1:] {
+		result = math.Min(result, scalar)
+	}
+
+	return result
+}
+
+// DominantAxis returns the axis of a vector with the largest absolute value
+func DominantAxis(v Vector) Axis {
+	return v.DominantAxis()
+}
+
+// DominantAxis returns the axis of a vector with the largest absolute value,
+// on a tie the first axis wins and X is returned for an empty vector
+func (v Vector) DominantAxis() Axis {
+	axis := X
+
+	for i := range v {
+		if math.Abs(v[i]) > math.Abs(v[axis]) {
+			axis = Axis(i)
+		}
+	}
+
+	return axis
+}
+
+// SetMagnitude returns a vector with the same direction and the given length
+func SetMagnitude(v Vector, length float64) Vector {
+	return v.Clone().SetMagnitude(length)
+}
+
+// SetMagnitude scales a vector to the given length keeping its direction, a
+// zero vector has no direction and is returned unchanged
+func (v Vector) SetMagnitude(length float64) Vector {
+	if l := v.Magnitude(); l >= 1e-8 {
+		v.Scale(length / l)
+	}
+
+	return v
+}
+
+// ManhattanDistance returns the distance between two points when moving
+// along the axis only, the sum of the absolute differences of each axis
+func ManhattanDistance(v1, v2 Vector) float64 {
+	return v1.ManhattanDistance(v2)
+}
+
+// ManhattanDistance returns the distance between two points when moving
+// along the axis only, missing components are treated as 0
+func (v Vector) ManhattanDistance(v2 Vector) float64 {
+	if len(v) < len(v2) {
+		v, v2 = v2, v
+	}
+
+	var result float64
+
+	for i := range v {
+		result += math.Abs(v[i] - at(v2, i))
+	}
+
+	return result
+}
+
+// ManhattanMagnitude returns the sum of the absolute values of the
+// components of a vector
+func ManhattanMagnitude(v Vector) float64 {
+	return v.ManhattanMagnitude()
+}
+
+// ManhattanMagnitude returns the sum of the absolute values of the
+// components of a vector
+func (v Vector) ManhattanMagnitude() float64 {
+	var result float64
+
+	for _, scalar := range v {
+		result += math.Abs(scalar)
+	}
+
+	return result
+}
+
+// ChebyshevDistance returns the largest absolute difference of any axis
+// between two points, it is the number of moves between two cells of a grid
+// when diagonal moves are allowed
+func ChebyshevDistance(v1, v2 Vector) float64 {
+	return v1.ChebyshevDistance(v2)
+}
+
+// ChebyshevDistance returns the largest absolute difference of any axis
+// between two points, missing components are treated as 0
+func (v Vector) ChebyshevDistance(v2 Vector) float64 {
+	if len(v) < len(v2) {
+		v, v2 = v2, v
+	}
+
+	var result float64
+
+	for i := range v {
+		result = math.Max(result, math.Abs(v[i]-at(v2, i)))
+	}
+
+	return result
+}
+
+// MinkowskiDistance returns the distance between two points using the L^p
+// norm, p of 1 is the Manhattan distance, 2 the euclidean distance and
+// infinity the Chebyshev distance
+func MinkowskiDistance(v1, v2 Vector, p float64) float64 {
+	return v1.MinkowskiDistance(v2, p)
+}
+
+// MinkowskiDistance returns the distance between two points using the L^p
+// norm, missing components are treated as 0. The result is only a true
+// distance for p of 1 or more.
+func (v Vector) MinkowskiDistance(v2 Vector, p float64) float64 {
+	if math.IsInf(p, 1) {
+		return v.ChebyshevDistance(v2)
+	}
+
+	if len(v) < len(v2) {
+		v, v2 = v2, v
+	}
+
+	var result float64
+
+	for i := range v {
+		result += math.Pow(math.Abs(v[i]-at(v2, i)), p)
+	}
+
+	return math.Pow(result, 1/p)
+}
+
+// CosineSimilarity returns the cosine of the angle between two vectors, 1
+// when they point the same way, 0 when they are perpendicular and -1 when
+// they are opposite
+func CosineSimilarity(v1, v2 Vector) float64 {
+	return v1.CosineSimilarity(v2)
+}
+
+// CosineSimilarity returns the cosine of the angle between two vectors,
+// missing components are treated as 0. A zero vector has no direction and
+// gives a similarity of 0.
+func (v Vector) CosineSimilarity(v2 Vector) float64 {
+	l1, l2 := v.Magnitude(), v2.Magnitude()
+
+	if l1 < 1e-8 || l2 < 1e-8 {
+		return 0
+	}
+
+	var dot float64
+
+	for i := 0; i < len(v) && i < len(v2); i++ {
+		dot += v[i] * v2[i]
+	}
+
+	return math.Max(-1, math.Min(1, dot/(l1*l2)))
+}
+
 // String returns the string representation of a vector
 func (v Vector) String() (str string) {
 	if v == nil {
@@ -301,3 +1326,49 @@ func (v Vector) Z() float64 {
 
 	return v[Z]
 }
+
+// W is corresponding to doing a v[3] lookup, if index 3 does not exist yet, a
+// 0 will be returned instead
+func (v Vector) W() float64 {
+	if len(v) < 4 {
+		return 0.
+	}
+
+	return v[W]
+}
+
+// Set is corresponding to doing a v[a] = value assignment, if the axis does
+// not exist yet the vector is grown with zeros. The vector is returned since
+// growing it may allocate a new one, use it like v = v.Set(vector.Z, 1).
+func (v Vector) Set(a Axis, value float64) Vector {
+	for len(v) <= int(a) {
+		v = append(v, 0)
+	}
+
+	v[a] = value
+	return v
+}
+
+// SetX is corresponding to doing a v[0] = value assignment, if index 0 does
+// not exist yet the vector is grown
+func (v Vector) SetX(value float64) Vector {
+	return v.Set(X, value)
+}
+
+// SetY is corresponding to doing a v[1] = value assignment, if index 1 does
+// not exist yet the vector is grown
+func (v Vector) SetY(value float64) Vector {
+	return v.Set(Y, value)
+}
+
+// SetZ is corresponding to doing a v[2] = value assignment, if index 2 does
+// not exist yet the vector is grown
+func (v Vector) SetZ(value float64) Vector {
+	return v.Set(Z, value)
+}
+
+// SetW is corresponding to doing a v[3] = value assignment, if index 3 does
+// not exist yet the vector is grown
+func (v Vector) SetW(value float64) Vector {
+	return v.Set(W, value)
+}
@@ -71,6 +71,22 @@ func TestRotationOfVector(t *testing.T) {
 
 }
 
+func TestCross(t *testing.T) {
+	cases := [][3]vec{
+		{{1, 0, 0}, {0, 1, 0}, {0, 0, 1}},
+		{{0, 1, 0}, {0, 0, 1}, {1, 0, 0}},
+		{{0, 0, 1}, {1, 0, 0}, {0, 1, 0}},
+		{{1, 2, 3}, {4, 5, 6}, {-3, 6, -3}},
+		{{2, 3, 0}, {5, 7, 0}, {0, 0, -1}},
+	}
+
+	for _, c := range cases {
+		if result, _ := vector.Cross(c[0], c[1]); !result.Equal(c[2]) {
+			t.Errorf("expected %v x %v to be %v, got %v", c[0], c[1], c[2], result)
+		}
+	}
+}
+
 func TestXYZGetters(t *testing.T) {
 	v1 := vec{}
 
@@ -83,6 +99,37 @@ func TestXYZGetters(t *testing.T) {
 	if v2.X() != 1 || v2.Y() != 2 || v2.Z() != 3 {
 		t.Error("getter methods for x, y, z did not return 0 when expected")
 	}
+
+	if v1.W() != 0 || v2.W() != 0 || (vec{1, 2, 3, 4}).W() != 4 {
+		t.Error("getter method for w did not return the expected value")
+	}
+}
+
+func TestRefractSnellsLaw(t *testing.T) {
+	in := vec{math.Sin(0.5), -math.Cos(0.5)}
+	out := vector.Refract(in, vec{0, 1}, 1/1.33)
+
+	if s := math.Sin(0.5) / 1.33; math.Abs(out.X()-s) > 1e-9 || math.Abs(out.Magnitude()-1) > 1e-9 {
+		t.Errorf("expected a unit direction with sin %v, got %v", s, out)
+	}
+}
+
+func TestXYZSetters(t *testing.T) {
+	v := vec{}.SetY(2)
+
+	if !v.Equal(vec{0, 2}) {
+		t.Errorf("expected setter to grow the vector to [0 2], got %v", v)
+	}
+
+	v = v.SetX(1).SetZ(3).SetW(4)
+
+	if !v.Equal(vec{1, 2, 3, 4}) {
+		t.Errorf("expected [1 2 3 4], got %v", v)
+	}
+
+	if v.Set(vector.Y, 5); v[1] != 5 {
+		t.Error("expected setter to modify the vector in place when it is large enough")
+	}
 }
 
 func Example() {
@@ -143,6 +190,34 @@ func ExampleVector_Equal() {
 	// Output: false
 }
 
+func ExampleUnitChecked() {
+	fmt.Println(
+		vector.UnitChecked(vec{0, 2}),
+	)
+	// Output: [0 1] <nil>
+}
+
+func ExampleVector_UnitChecked() {
+	fmt.Println(
+		vec{0, 0}.UnitChecked(),
+	)
+	// Output: [] vector has zero length
+}
+
+func ExampleNormalized() {
+	fmt.Println(
+		vector.Normalized(vec{3, 4}),
+	)
+	// Output: [0.6 0.8] 5
+}
+
+func ExampleVector_Normalized() {
+	fmt.Println(
+		vec{0, 0, 2}.Normalized(),
+	)
+	// Output: [0 0 1] 2
+}
+
 func ExampleDot() {
 	fmt.Println(
 		vector.Dot(vec{0, 2}, vec{2, 0}),
@@ -161,14 +236,14 @@ func ExampleCross() {
 	fmt.Println(
 		vector.Cross(vec{0, 1, 2}, vec{3, 2, 1}),
 	)
-	// Output: [-3 6 -6] <nil>
+	// Output: [-3 6 -3] <nil>
 }
 
 func ExampleVector_Cross() {
 	fmt.Println(
 		vec{0, 1, 2}.Cross(vec{3, 2, 1}),
 	)
-	// Output: [-3 6 -6] <nil>
+	// Output: [-3 6 -3] <nil>
 }
 
 func ExampleClone() {
@@ -213,6 +288,20 @@ func ExampleVector_Magnitude() {
 	// Output: 2.23606797749979
 }
 
+func ExampleMagnitudeSquared() {
+	fmt.Println(
+		vector.MagnitudeSquared(vec{3, 4}),
+	)
+	// Output: 25
+}
+
+func ExampleVector_MagnitudeSquared() {
+	fmt.Println(
+		vec{1, 2, 2}.MagnitudeSquared(),
+	)
+	// Output: 9
+}
+
 func ExampleUnit() {
 	fmt.Println(
 		vector.Unit(vec{1, 2}),
@@ -241,6 +330,766 @@ func ExampleVector_Rotate() {
 	// Output: [0 0 -1]
 }
 
+func ExampleRotateAroundAxisPoint() {
+	fmt.Println(
+		vector.RotateAroundAxisPoint(vec{2, 1, 0}, vec{0, 0, 1}, vec{1, 1, 0}, math.Pi/2),
+	)
+	// Output: [1 2 0]
+}
+
+func ExampleVector_RotateAroundAxisPoint() {
+	fmt.Println(
+		vec{1, 0, 0}.RotateAroundAxisPoint(vec{1, 1, 1}, nil, 2*math.Pi/3),
+	)
+	// Output: [0 1 0]
+}
+
+func ExampleMirrorAcrossPlane() {
+	fmt.Println(
+		vector.MirrorAcrossPlane(vec{1, 3, 2}, vector.NewPlane(vec{0, 1, 0}, vec{0, 1, 0})),
+	)
+	// Output: [1 -1 2]
+}
+
+func ExampleVector_MirrorAcrossPlane() {
+	fmt.Println(
+		vec{3, 1}.MirrorAcrossPlane(vector.NewPlane(vec{1, 0}, vec{2, 0})),
+	)
+	// Output: [1 1]
+}
+
+func ExampleMirrorAxis() {
+	fmt.Println(
+		vector.MirrorAxis(vec{1, 2, 3}, vector.X),
+	)
+	// Output: [-1 2 3]
+}
+
+func ExampleVector_MirrorAxis() {
+	fmt.Println(
+		vec{1, 2}.MirrorAxis(vector.Y),
+		vec{1, 2}.MirrorAxis(vector.Z),
+	)
+	// Output: [1 -2] [1 2]
+}
+
+func ExampleRefract() {
+	fmt.Println(
+		vector.Refract(vec{0, -1}, vec{0, 1}, 1.5),
+	)
+	// Output: [0 -1]
+}
+
+func ExampleVector_Refract() {
+	fmt.Println(
+		vec{1, -1}.Unit().Refract(vec{0, 1}, 1.5),
+	)
+	// Output: [0 0]
+}
+
+func ExampleProject() {
+	fmt.Println(
+		vector.Project(vec{3, 4}, vec{2, 0}),
+	)
+	// Output: [3 0]
+}
+
+func ExampleVector_Project() {
+	fmt.Println(
+		vec{1, 3}.Project(vec{1, 1}),
+	)
+	// Output: [2 2]
+}
+
+func ExampleReject() {
+	fmt.Println(
+		vector.Reject(vec{3, 4}, vec{2, 0}),
+	)
+	// Output: [0 4]
+}
+
+func ExampleVector_Reject() {
+	fmt.Println(
+		vec{1, 3, 2}.Reject(vec{0, 0, 1}),
+	)
+	// Output: [1 3 0]
+}
+
+func ExampleDistance() {
+	fmt.Println(
+		vector.Distance(vec{1, 1}, vec{4, 5}),
+	)
+	// Output: 5
+}
+
+func ExampleVector_Distance() {
+	fmt.Println(
+		vec{0, 3}.Distance(vec{4, 0, 0}),
+	)
+	// Output: 5
+}
+
+func ExampleDistanceSquared() {
+	fmt.Println(
+		vector.DistanceSquared(vec{1, 1}, vec{4, 5}),
+	)
+	// Output: 25
+}
+
+func ExampleVector_DistanceSquared() {
+	fmt.Println(
+		vec{1, 2, 3}.DistanceSquared(vec{1, 2}),
+	)
+	// Output: 9
+}
+
+func ExampleClampMagnitude() {
+	fmt.Println(
+		vector.ClampMagnitude(vec{6, 8}, 5),
+		vector.ClampMagnitude(vec{3, 4}, 10),
+	)
+	// Output: [3 4] [3 4]
+}
+
+func ExampleVector_ClampMagnitude() {
+	fmt.Println(
+		vec{0, 10, 0}.ClampMagnitude(2),
+	)
+	// Output: [0 2 0]
+}
+
+func ExampleClampMagnitudeRange() {
+	fmt.Println(
+		vector.ClampMagnitudeRange(vec{0.3, 0.4}, 1, 2),
+		vector.ClampMagnitudeRange(vec{0, 0}, 1, 2),
+	)
+	// Output: [0.6 0.8] [0 0]
+}
+
+func ExampleVector_ClampMagnitudeRange() {
+	fmt.Println(
+		vec{6, 8}.ClampMagnitudeRange(1, 5),
+	)
+	// Output: [3 4]
+}
+
+func ExampleClamp() {
+	fmt.Println(
+		vector.Clamp(vec{-1, 5, 2}, vec{0, 0, 0}, vec{4, 4, 4}),
+	)
+	// Output: [0 4 2]
+}
+
+func ExampleVector_Clamp() {
+	fmt.Println(
+		vec{-1, 5, 9}.Clamp(vec{0, 0}, vec{4, 4, 4, 4}),
+	)
+	// Output: [0 4 4]
+}
+
+func ExampleMin() {
+	fmt.Println(
+		vector.Min(vec{1, 5, 3}, vec{4, 2, 3}),
+	)
+	// Output: [1 2 3]
+}
+
+func ExampleVector_Min() {
+	fmt.Println(
+		vec{1, 5, 3}.Min(vec{4, 2}),
+	)
+	// Output: [1 2 3]
+}
+
+func ExampleMax() {
+	fmt.Println(
+		vector.Max(vec{1, 5, 3}, vec{4, 2, 3}),
+	)
+	// Output: [4 5 3]
+}
+
+func ExampleVector_Max() {
+	fmt.Println(
+		vec{1, 5}.Max(vec{4, 2, 9}),
+	)
+	// Output: [4 5]
+}
+
+func ExampleAbs() {
+	fmt.Println(
+		vector.Abs(vec{-1, 2, -3}),
+	)
+	// Output: [1 2 3]
+}
+
+func ExampleVector_Abs() {
+	fmt.Println(
+		vec{-1.5, 0}.Abs(),
+	)
+	// Output: [1.5 0]
+}
+
+func ExampleNegate() {
+	fmt.Println(
+		vector.Negate(vec{-1, 2, -3}),
+	)
+	// Output: [1 -2 3]
+}
+
+func ExampleVector_Negate() {
+	fmt.Println(
+		vec{1, -2}.Negate(),
+	)
+	// Output: [-1 2]
+}
+
+func ExampleInvert() {
+	fmt.Println(
+		vector.Invert(vec{1, -2}),
+	)
+	// Output: [-1 2]
+}
+
+func ExampleVector_Invert() {
+	fmt.Println(
+		vec{-3, 4}.Invert(),
+	)
+	// Output: [3 -4]
+}
+
+func ExampleInvertComponents() {
+	fmt.Println(
+		vector.InvertComponents(vec{2, -4, 0.5}),
+	)
+	// Output: [0.5 -0.25 2]
+}
+
+func ExampleVector_InvertComponents() {
+	fmt.Println(
+		vec{4, 1}.InvertComponents(),
+	)
+	// Output: [0.25 1]
+}
+
+func ExampleFloor() {
+	fmt.Println(
+		vector.Floor(vec{1.7, -1.2}),
+	)
+	// Output: [1 -2]
+}
+
+func ExampleVector_Floor() {
+	fmt.Println(
+		vec{3.5, 2}.Floor(),
+	)
+	// Output: [3 2]
+}
+
+func ExampleCeil() {
+	fmt.Println(
+		vector.Ceil(vec{1.2, -1.7}),
+	)
+	// Output: [2 -1]
+}
+
+func ExampleVector_Ceil() {
+	fmt.Println(
+		vec{3.5, 2}.Ceil(),
+	)
+	// Output: [4 2]
+}
+
+func ExampleRound() {
+	fmt.Println(
+		vector.Round(vec{1.4, -1.6, 2.5}),
+	)
+	// Output: [1 -2 3]
+}
+
+func ExampleVector_Round() {
+	fmt.Println(
+		vec{3.5, 2.49}.Round(),
+	)
+	// Output: [4 2]
+}
+
+func ExampleSnap() {
+	fmt.Println(
+		vector.Snap(vec{13, 28, 2}, 8),
+	)
+	// Output: [16 32 0]
+}
+
+func ExampleVector_Snap() {
+	fmt.Println(
+		vec{0.3, 0.8}.Snap(0.5),
+	)
+	// Output: [0.5 1]
+}
+
+func ExampleSnapVec() {
+	fmt.Println(
+		vector.SnapVec(vec{13, 28}, vec{10, 16}),
+	)
+	// Output: [10 32]
+}
+
+func ExampleVector_SnapVec() {
+	fmt.Println(
+		vec{13, 28, 1.3}.SnapVec(vec{4, 0}),
+	)
+	// Output: [12 28 1.3]
+}
+
+func ExampleMult() {
+	fmt.Println(
+		vector.Mult(vec{1, 2, 3}, vec{4, 5, 6}),
+	)
+	// Output: [4 10 18]
+}
+
+func ExampleVector_Mult() {
+	fmt.Println(
+		vec{1, 2, 3}.Mult(vec{2, 0.5}),
+	)
+	// Output: [2 1 3]
+}
+
+func ExampleDiv() {
+	fmt.Println(
+		vector.Div(vec{4, 10, 18}, vec{4, 5, 6}),
+	)
+	fmt.Println(
+		vector.Div(vec{1, 2}, vec{1, 0}),
+	)
+	// Output:
+	// [1 2 3] <nil>
+	// [] division by zero
+}
+
+func ExampleVector_Div() {
+	fmt.Println(
+		vec{2, 1, 3}.Div(vec{2, 0.5}),
+	)
+	// Output: [1 2 3] <nil>
+}
+
+func ExampleMod() {
+	fmt.Println(
+		vector.Mod(vec{7, -1, 3}, 3),
+	)
+	// Output: [1 2 0]
+}
+
+func ExampleVector_Mod() {
+	fmt.Println(
+		vec{5.5, -0.5}.Mod(2),
+	)
+	// Output: [1.5 1.5]
+}
+
+func ExampleWrap() {
+	fmt.Println(
+		vector.Wrap(vec{650, -10}, vec{0, 0}, vec{640, 480}),
+	)
+	// Output: [10 470]
+}
+
+func ExampleVector_Wrap() {
+	fmt.Println(
+		vec{3, 3, 3}.Wrap(vec{-1, 0}, vec{1, 5}),
+	)
+	// Output: [-1 3 3]
+}
+
+func ExampleMoveToward() {
+	fmt.Println(
+		vector.MoveToward(vec{0, 0}, vec{6, 8}, 5),
+		vector.MoveToward(vec{0, 0}, vec{6, 8}, 20),
+	)
+	// Output: [3 4] [6 8]
+}
+
+func ExampleVector_MoveToward() {
+	fmt.Println(
+		vec{1, 1, 1}.MoveToward(vec{1, 4, 1}, 1),
+	)
+	// Output: [1 2 1]
+}
+
+func ExampleSwizzle() {
+	fmt.Println(
+		vector.Swizzle(vec{1, 2, 3}, "zyx"),
+	)
+	// Output: [3 2 1]
+}
+
+func ExampleVector_Swizzle() {
+	fmt.Println(
+		vec{1, 2}.Swizzle("xxyz"),
+	)
+	// Output: [1 1 2 0]
+}
+
+func ExampleSwizzleAxis() {
+	fmt.Println(
+		vector.SwizzleAxis(vec{1, 2, 3}, vector.X, vector.Z, vector.Y),
+	)
+	// Output: [1 3 2]
+}
+
+func ExampleVector_SwizzleAxis() {
+	fmt.Println(
+		vec{1, 2}.SwizzleAxis(vector.Y, vector.Y),
+	)
+	// Output: [2 2]
+}
+
+func ExampleVector_Set() {
+	v := vec{1}
+	v = v.Set(vector.Z, 3)
+
+	fmt.Println(v)
+	// Output: [1 0 3]
+}
+
+func ExampleResize() {
+	fmt.Println(
+		vector.Resize(vec{1, 2, 3}, 2),
+		vector.Resize(vec{1, 2}, 4),
+	)
+	// Output: [1 2] [1 2 0 0]
+}
+
+func ExampleVector_Resize() {
+	v := vec{1}
+	v = v.Resize(3)
+
+	fmt.Println(v)
+	// Output: [1 0 0]
+}
+
+func ExampleTo2D() {
+	fmt.Println(
+		vector.To2D(vec{1, 2, 3}),
+	)
+	// Output: [1 2]
+}
+
+func ExampleVector_To2D() {
+	fmt.Println(
+		vec{1}.To2D(),
+	)
+	// Output: [1 0]
+}
+
+func ExampleTo3D() {
+	fmt.Println(
+		vector.To3D(vec{1, 2}),
+	)
+	// Output: [1 2 0]
+}
+
+func ExampleVector_To3D() {
+	fmt.Println(
+		vec{1, 2, 3, 4}.To3D(),
+	)
+	// Output: [1 2 3]
+}
+
+func ExampleTo4D() {
+	fmt.Println(
+		vector.To4D(vec{1, 2, 3}, 1),
+	)
+	// Output: [1 2 3 1]
+}
+
+func ExampleVector_To4D() {
+	fmt.Println(
+		vec{1, 2}.To4D(0),
+	)
+	// Output: [1 2 0 0]
+}
+
+func ExampleSum() {
+	fmt.Println(
+		vector.Sum(vec{1, 2, 3}),
+	)
+	// Output: 6
+}
+
+func ExampleVector_Sum() {
+	fmt.Println(
+		vec{}.Sum(),
+	)
+	// Output: 0
+}
+
+func ExampleProduct() {
+	fmt.Println(
+		vector.Product(vec{2, 3, 4}),
+	)
+	// Output: 24
+}
+
+func ExampleVector_Product() {
+	fmt.Println(
+		vec{}.Product(),
+	)
+	// Output: 1
+}
+
+func ExampleMean() {
+	fmt.Println(
+		vector.Mean(vec{1, 2, 6}),
+	)
+	// Output: 3
+}
+
+func ExampleVector_Mean() {
+	fmt.Println(
+		vec{}.Mean(),
+	)
+	// Output: 0
+}
+
+func ExampleIsZero() {
+	fmt.Println(
+		vector.IsZero(vec{0, 1e-10}),
+		vector.IsZero(vec{0, 0.1}),
+	)
+	// Output: true false
+}
+
+func ExampleVector_IsZero() {
+	fmt.Println(
+		vec{}.IsZero(),
+	)
+	// Output: true
+}
+
+func ExampleIsZeroEps() {
+	fmt.Println(
+		vector.IsZeroEps(vec{0.05, -0.1}, 0.15),
+	)
+	// Output: true
+}
+
+func ExampleVector_IsZeroEps() {
+	fmt.Println(
+		vec{0.05, -0.2}.IsZeroEps(0.15),
+	)
+	// Output: false
+}
+
+func ExampleIsValid() {
+	fmt.Println(
+		vector.IsValid(vec{1, 2}),
+		vector.IsValid(vec{1, math.NaN()}),
+	)
+	// Output: true false
+}
+
+func ExampleVector_IsValid() {
+	fmt.Println(
+		vec{math.Inf(-1), 0}.IsValid(),
+	)
+	// Output: false
+}
+
+func ExampleSanitize() {
+	fmt.Println(
+		vector.Sanitize(vec{1, math.NaN(), math.Inf(1)}),
+	)
+	// Output: [1 0 0]
+}
+
+func ExampleVector_Sanitize() {
+	fmt.Println(
+		vec{math.Inf(-1), 2}.Sanitize(),
+	)
+	// Output: [0 2]
+}
+
+func ExampleScaleVec() {
+	fmt.Println(
+		vector.ScaleVec(vec{2, 2}, vec{1.5, 0.5}),
+	)
+	// Output: [3 1]
+}
+
+func ExampleVector_ScaleVec() {
+	fmt.Println(
+		vec{1, 2, 3}.ScaleVec(vec{2}),
+	)
+	// Output: [2 2 3]
+}
+
+func ExampleDivScalar() {
+	fmt.Println(
+		vector.DivScalar(vec{2, 4}, 2),
+	)
+	fmt.Println(
+		vector.DivScalar(vec{2, 4}, 0),
+	)
+	// Output:
+	// [1 2] <nil>
+	// [] division by zero
+}
+
+func ExampleVector_DivScalar() {
+	fmt.Println(
+		vec{3, 6, 9}.DivScalar(3),
+	)
+	// Output: [1 2 3] <nil>
+}
+
+func ExampleAddScaled() {
+	fmt.Println(
+		vector.AddScaled(vec{1, 2}, vec{2, 4}, 0.5),
+	)
+	// Output: [2 4]
+}
+
+func ExampleVector_AddScaled() {
+	position, velocity := vec{0, 10}, vec{2, -1, 5}
+
+	fmt.Println(
+		position.AddScaled(velocity, 2),
+	)
+	// Output: [4 8]
+}
+
+func ExampleMaxComponent() {
+	fmt.Println(
+		vector.MaxComponent(vec{1, -5, 3}),
+	)
+	// Output: 3
+}
+
+func ExampleVector_MaxComponent() {
+	fmt.Println(
+		vec{-2, -1}.MaxComponent(),
+	)
+	// Output: -1
+}
+
+func ExampleMinComponent() {
+	fmt.Println(
+		vector.MinComponent(vec{1, -5, 3}),
+	)
+	// Output: -5
+}
+
+func ExampleVector_MinComponent() {
+	fmt.Println(
+		vec{2, 1}.MinComponent(),
+	)
+	// Output: 1
+}
+
+func ExampleDominantAxis() {
+	fmt.Println(
+		vector.DominantAxis(vec{1, -5, 3}) == vector.Y,
+	)
+	// Output: true
+}
+
+func ExampleVector_DominantAxis() {
+	fmt.Println(
+		vec{0.2, 0.1}.DominantAxis() == vector.X,
+	)
+	// Output: true
+}
+
+func ExampleSetMagnitude() {
+	fmt.Println(
+		vector.SetMagnitude(vec{3, 4}, 10),
+		vector.SetMagnitude(vec{0, 0}, 10),
+	)
+	// Output: [6 8] [0 0]
+}
+
+func ExampleVector_SetMagnitude() {
+	fmt.Println(
+		vec{0, 5, 0}.SetMagnitude(2),
+	)
+	// Output: [0 2 0]
+}
+
+func ExampleManhattanDistance() {
+	fmt.Println(
+		vector.ManhattanDistance(vec{1, 1}, vec{4, -3}),
+	)
+	// Output: 7
+}
+
+func ExampleVector_ManhattanDistance() {
+	fmt.Println(
+		vec{1, 2, 3}.ManhattanDistance(vec{1}),
+	)
+	// Output: 5
+}
+
+func ExampleManhattanMagnitude() {
+	fmt.Println(
+		vector.ManhattanMagnitude(vec{3, -4}),
+	)
+	// Output: 7
+}
+
+func ExampleVector_ManhattanMagnitude() {
+	fmt.Println(
+		vec{-1, -1, 1}.ManhattanMagnitude(),
+	)
+	// Output: 3
+}
+
+func ExampleChebyshevDistance() {
+	fmt.Println(
+		vector.ChebyshevDistance(vec{1, 1}, vec{4, -3}),
+	)
+	// Output: 4
+}
+
+func ExampleVector_ChebyshevDistance() {
+	fmt.Println(
+		vec{1, 2}.ChebyshevDistance(vec{1, 2, -5}),
+	)
+	// Output: 5
+}
+
+func ExampleMinkowskiDistance() {
+	fmt.Println(
+		vector.MinkowskiDistance(vec{0, 0}, vec{3, 4}, 1),
+		vector.MinkowskiDistance(vec{0, 0}, vec{3, 4}, 2),
+		vector.MinkowskiDistance(vec{0, 0}, vec{3, 4}, math.Inf(1)),
+	)
+	// Output: 7 5 4
+}
+
+func ExampleVector_MinkowskiDistance() {
+	fmt.Printf("%.4f\n", vec{0, 0}.MinkowskiDistance(vec{1, 1}, 3))
+	// Output: 1.2599
+}
+
+func ExampleCosineSimilarity() {
+	fmt.Println(
+		vector.CosineSimilarity(vec{1, 0}, vec{5, 0}),
+		vector.CosineSimilarity(vec{1, 0}, vec{0, 3}),
+		vector.CosineSimilarity(vec{1, 0}, vec{-2, 0}),
+	)
+	// Output: 1 0 -1
+}
+
+func ExampleVector_CosineSimilarity() {
+	fmt.Println(
+		vec{0, 0}.CosineSimilarity(vec{1, 2}),
+		vec{2, 0, 0, 0}.CosineSimilarity(vec{1}),
+	)
+	// Output: 0 1
+}
+
 func BenchmarkAdd(b *testing.B) {
 	b.ReportAllocs()
 	v1, v2 := vec{1, 2}, vec{2, 3}
@@ -259,6 +1108,15 @@ func BenchmarkVector_Add(b *testing.B) {
 	}
 }
 
+func BenchmarkVector_AddScaled(b *testing.B) {
+	b.ReportAllocs()
+	v1, v2 := vec{1, 2}, vec{2, 1}
+
+	for i := 0; i < b.N; i++ {
+		v1.AddScaled(v2, 0.5)
+	}
+}
+
 func BenchmarkSub(b *testing.B) {
 	b.ReportAllocs()
 	v1, v2 := vec{1, 2}, vec{2, 3}
@@ -340,6 +1198,15 @@ func BenchmarkDot(b *testing.B) {
 	}
 }
 
+func BenchmarkDistanceSquared(b *testing.B) {
+	b.ReportAllocs()
+	v1, v2 := vec{1, 2}, vec{2, 1}
+
+	for i := 0; i < b.N; i++ {
+		vector.DistanceSquared(v1, v2)
+	}
+}
+
 func BenchmarkCross(b *testing.B) {
 	b.ReportAllocs()
 	v1, v2 := vec{1, 2, 3}, vec{3, 2, 1}
@@ -385,6 +1252,15 @@ func BenchmarkVector_Rotate(b *testing.B) {
 	}
 }
 
+func BenchmarkRotateAroundAxisPoint(b *testing.B) {
+	b.ReportAllocs()
+	v, axis, pivot := vec{1, 2, 3}, vec{1, 1, 0}, vec{0, 1, 0}
+
+	for i := 0; i < b.N; i++ {
+		v.RotateAroundAxisPoint(axis, pivot, math.Pi/2)
+	}
+}
+
 func BenchmarkSlicing(b *testing.B) {
 	b.ReportAllocs()
 	v := make(vec, 100)